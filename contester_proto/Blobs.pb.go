@@ -23,21 +23,41 @@
 		LocalExecutionParameters
 		LocalExecuteConnected
 		LocalExecutionResult
+		SetupFailure
+		RepeatStatistics
 		LocalExecuteConnectedResult
+		LocalExecuteGraph
+		LocalExecuteGraphResult
 		LocalExecution
 		BinaryTypeRequest
 		BinaryTypeResponse
 		ClearSandboxRequest
+		AcquireSandboxRequest
+		SandboxLease
+		RenewLeaseRequest
+		ReleaseSandboxRequest
+		SnapshotRequest
 		IdentifyRequest
+		DiskUsage
 		SandboxLocations
 		IdentifyResponse
+		DownloadCacheStats
+		CalibrateRequest
+		CalibrateResponse
 		FileStat
 		StatRequest
 		FileStats
 		GetRequest
+		PutChunkRequest
+		GetChunkRequest
+		FileChunk
+		PutArchiveRequest
+		GetArchiveRequest
+		Archive
 		EmptyMessage
 		CopyOperation
 		CopyOperations
+		CopyResult
 		NamePair
 		RepeatedNamePairEntries
 		RepeatedStringEntries
@@ -98,10 +118,13 @@ func (Blob_CompressionInfo_CompressionType) EnumDescriptor() ([]byte, []int) {
 }
 
 type Blob struct {
-	Data             []byte                `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
-	Compression      *Blob_CompressionInfo `protobuf:"bytes,2,opt,name=compression" json:"compression,omitempty"`
-	Sha1             []byte                `protobuf:"bytes,3,opt,name=sha1" json:"sha1,omitempty"`
-	XXX_unrecognized []byte                `json:"-"`
+	Data        []byte                `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Compression *Blob_CompressionInfo `protobuf:"bytes,2,opt,name=compression" json:"compression,omitempty"`
+	Sha1        []byte                `protobuf:"bytes,3,opt,name=sha1" json:"sha1,omitempty"`
+	// Checksum of the uncompressed data as algo:hex, where algo is sha256 or blake3; sha1
+	// is only in the field above.
+	Digest           *string `protobuf:"bytes,4,opt,name=digest" json:"digest,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *Blob) Reset()                    { *m = Blob{} }
@@ -130,6 +153,13 @@ func (m *Blob) GetSha1() []byte {
 	return nil
 }
 
+func (m *Blob) GetDigest() string {
+	if m != nil && m.Digest != nil {
+		return *m.Digest
+	}
+	return ""
+}
+
 type Blob_CompressionInfo struct {
 	Method           *Blob_CompressionInfo_CompressionType `protobuf:"varint,1,opt,name=method,enum=contester.proto.Blob_CompressionInfo_CompressionType" json:"method,omitempty"`
 	OriginalSize     *uint32                               `protobuf:"varint,2,opt,name=original_size,json=originalSize" json:"original_size,omitempty"`
@@ -189,9 +219,15 @@ func (m *Module) GetType() string {
 }
 
 type FileBlob struct {
-	Name             *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Data             *Blob   `protobuf:"bytes,2,opt,name=data" json:"data,omitempty"`
-	XXX_unrecognized []byte  `json:"-"`
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Data *Blob   `protobuf:"bytes,2,opt,name=data" json:"data,omitempty"`
+	// Token of the sandbox lease, for Put.
+	Lease *string `protobuf:"bytes,3,opt,name=lease" json:"lease,omitempty"`
+	// Permission bits, 0644 for Put if not set.
+	Mode *uint32 `protobuf:"varint,4,opt,name=mode" json:"mode,omitempty"`
+	// Modification time, microseconds since the epoch.
+	MtimeMicros      *int64 `protobuf:"varint,5,opt,name=mtime_micros,json=mtimeMicros" json:"mtime_micros,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *FileBlob) Reset()                    { *m = FileBlob{} }
@@ -213,6 +249,27 @@ func (m *FileBlob) GetData() *Blob {
 	return nil
 }
 
+func (m *FileBlob) GetLease() string {
+	if m != nil && m.Lease != nil {
+		return *m.Lease
+	}
+	return ""
+}
+
+func (m *FileBlob) GetMode() uint32 {
+	if m != nil && m.Mode != nil {
+		return *m.Mode
+	}
+	return 0
+}
+
+func (m *FileBlob) GetMtimeMicros() int64 {
+	if m != nil && m.MtimeMicros != nil {
+		return *m.MtimeMicros
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Blob)(nil), "contester.proto.Blob")
 	proto.RegisterType((*Blob_CompressionInfo)(nil), "contester.proto.Blob.CompressionInfo")
@@ -257,6 +314,12 @@ func (m *Blob) MarshalTo(data []byte) (int, error) {
 		i = encodeVarintBlobs(data, i, uint64(len(m.Sha1)))
 		i += copy(data[i:], m.Sha1)
 	}
+	if m.Digest != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintBlobs(data, i, uint64(len(*m.Digest)))
+		i += copy(data[i:], *m.Digest)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -370,6 +433,22 @@ func (m *FileBlob) MarshalTo(data []byte) (int, error) {
 		}
 		i += n3
 	}
+	if m.Lease != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintBlobs(data, i, uint64(len(*m.Lease)))
+		i += copy(data[i:], *m.Lease)
+	}
+	if m.Mode != nil {
+		data[i] = 0x20
+		i++
+		i = encodeVarintBlobs(data, i, uint64(*m.Mode))
+	}
+	if m.MtimeMicros != nil {
+		data[i] = 0x28
+		i++
+		i = encodeVarintBlobs(data, i, uint64(*m.MtimeMicros))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -418,6 +497,10 @@ func (m *Blob) Size() (n int) {
 		l = len(m.Sha1)
 		n += 1 + l + sovBlobs(uint64(l))
 	}
+	if m.Digest != nil {
+		l = len(*m.Digest)
+		n += 1 + l + sovBlobs(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -471,6 +554,16 @@ func (m *FileBlob) Size() (n int) {
 		l = m.Data.Size()
 		n += 1 + l + sovBlobs(uint64(l))
 	}
+	if m.Lease != nil {
+		l = len(*m.Lease)
+		n += 1 + l + sovBlobs(uint64(l))
+	}
+	if m.Mode != nil {
+		n += 1 + sovBlobs(uint64(*m.Mode))
+	}
+	if m.MtimeMicros != nil {
+		n += 1 + sovBlobs(uint64(*m.MtimeMicros))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -614,6 +707,36 @@ func (m *Blob) Unmarshal(data []byte) error {
 				m.Sha1 = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Digest = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlobs(data[iNdEx:])
@@ -965,6 +1088,76 @@ func (m *FileBlob) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlobs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Lease = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mode = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MtimeMicros", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MtimeMicros = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlobs(data[iNdEx:])
@@ -1096,25 +1289,29 @@ var (
 )
 
 var fileDescriptorBlobs = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0xe2, 0x76, 0xca, 0xc9, 0x4f,
-	0x2a, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x4f, 0xce, 0xcf, 0x2b, 0x49, 0x2d, 0x2e,
-	0x49, 0x2d, 0x82, 0x08, 0x28, 0x6d, 0x61, 0xe2, 0x62, 0x01, 0x29, 0x10, 0x12, 0xe2, 0x62, 0x49,
-	0x49, 0x2c, 0x49, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x09, 0x02, 0xb3, 0x85, 0xdc, 0xb9, 0xb8,
-	0x93, 0xf3, 0x73, 0x0b, 0x8a, 0x52, 0x8b, 0x8b, 0x33, 0xf3, 0xf3, 0x24, 0x98, 0x80, 0x52, 0xdc,
-	0x46, 0xaa, 0x7a, 0x68, 0x66, 0xe8, 0x81, 0xf4, 0xeb, 0x39, 0x23, 0x14, 0x7a, 0xe6, 0xa5, 0xe5,
-	0x07, 0x21, 0xeb, 0x04, 0x19, 0x5e, 0x9c, 0x91, 0x68, 0x28, 0xc1, 0x0c, 0x31, 0x1c, 0xc4, 0x96,
-	0xda, 0xc5, 0xc8, 0xc5, 0x8f, 0xa6, 0x49, 0xc8, 0x97, 0x8b, 0x2d, 0x37, 0xb5, 0x24, 0x23, 0x3f,
-	0x05, 0xec, 0x0c, 0x3e, 0x23, 0x53, 0xa2, 0xec, 0x42, 0xe6, 0x87, 0x54, 0x16, 0xa4, 0x06, 0x41,
-	0x0d, 0x11, 0x52, 0xe6, 0xe2, 0xcd, 0x2f, 0xca, 0x4c, 0xcf, 0xcc, 0x4b, 0xcc, 0x89, 0x2f, 0xce,
-	0xac, 0x4a, 0x05, 0xfb, 0x80, 0x37, 0x88, 0x07, 0x26, 0x18, 0x0c, 0x14, 0x53, 0x32, 0x46, 0x71,
-	0x06, 0x48, 0xbf, 0x10, 0x3f, 0x17, 0xb7, 0xaf, 0x6b, 0x88, 0x87, 0xbf, 0x4b, 0xbc, 0x9f, 0xbf,
-	0x9f, 0xab, 0x00, 0x03, 0x92, 0x40, 0x94, 0x8f, 0xa7, 0x93, 0x00, 0xa3, 0x52, 0x34, 0x17, 0x9b,
-	0x6f, 0x7e, 0x4a, 0x69, 0x4e, 0x2a, 0xc8, 0x6b, 0x25, 0x40, 0x3d, 0x60, 0x07, 0x73, 0x06, 0x81,
-	0xd9, 0x42, 0x9a, 0xd0, 0xb0, 0x84, 0x04, 0x98, 0x28, 0x56, 0x4f, 0x40, 0x83, 0x18, 0xa8, 0x3d,
-	0x2f, 0x31, 0x37, 0x15, 0x1c, 0x32, 0x40, 0xed, 0x20, 0xb6, 0x92, 0x27, 0x17, 0x87, 0x5b, 0x66,
-	0x4e, 0x2a, 0x2c, 0x5a, 0xc0, 0xf2, 0x8c, 0x0a, 0x4c, 0x30, 0x79, 0x12, 0x8c, 0x77, 0xd2, 0x3b,
-	0xf1, 0x48, 0x8e, 0xf1, 0x02, 0x10, 0x3f, 0x00, 0xe2, 0x19, 0x8f, 0xe5, 0x18, 0xb8, 0x64, 0xf2,
-	0x8b, 0xd2, 0xf5, 0x8a, 0x4b, 0x32, 0xf3, 0xd2, 0x8b, 0x12, 0x2b, 0xd1, 0xb5, 0x02, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x3a, 0x1d, 0x04, 0xd6, 0x2d, 0x02, 0x00, 0x00,
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0x41, 0x6a, 0xdb, 0x40,
+	0x14, 0xf5, 0xd8, 0xb2, 0xa9, 0xbf, 0xec, 0xca, 0x0c, 0x6d, 0x11, 0xa5, 0x08, 0x55, 0xa5, 0xa0,
+	0x6e, 0x04, 0x75, 0xe9, 0x05, 0xdc, 0xba, 0x8d, 0x21, 0xb2, 0x61, 0xe2, 0x55, 0xb2, 0x30, 0x8a,
+	0x35, 0x91, 0x07, 0x24, 0x8d, 0xd0, 0x4c, 0x16, 0xf6, 0x41, 0x42, 0xce, 0x92, 0x13, 0x64, 0x91,
+	0x45, 0x8e, 0x10, 0x9c, 0x8b, 0x04, 0x8d, 0x24, 0x62, 0x44, 0x16, 0xc9, 0xee, 0xfd, 0xc7, 0xfb,
+	0x6f, 0xde, 0x7f, 0x03, 0xfa, 0x24, 0xe6, 0xe7, 0xc2, 0xcb, 0x72, 0x2e, 0x39, 0x36, 0xd6, 0x3c,
+	0x95, 0x54, 0x48, 0x9a, 0x97, 0x84, 0x73, 0xd7, 0x06, 0xad, 0x10, 0x60, 0x0c, 0x5a, 0x18, 0xc8,
+	0xc0, 0x44, 0x36, 0x72, 0x07, 0x44, 0x61, 0xfc, 0x1f, 0xf4, 0x35, 0x4f, 0xb2, 0x9c, 0x0a, 0xc1,
+	0x78, 0x6a, 0xb6, 0x6d, 0xe4, 0xea, 0xe3, 0xef, 0x5e, 0xc3, 0xc3, 0x2b, 0xf6, 0xbd, 0x3f, 0xcf,
+	0xc2, 0x59, 0x7a, 0xc1, 0xc9, 0xe1, 0x66, 0x61, 0x2e, 0x36, 0xc1, 0x4f, 0xb3, 0x53, 0x9a, 0x17,
+	0x18, 0x7f, 0x82, 0x5e, 0xc8, 0x22, 0x2a, 0xa4, 0xa9, 0xd9, 0xc8, 0xed, 0x93, 0x6a, 0xfa, 0x7c,
+	0x83, 0xc0, 0x68, 0x98, 0x61, 0x1f, 0x7a, 0x09, 0x95, 0x1b, 0x1e, 0xaa, 0x78, 0xef, 0xc7, 0xbf,
+	0x5f, 0x95, 0xe1, 0x70, 0x5e, 0x6e, 0x33, 0x4a, 0x2a, 0x13, 0xfc, 0x0d, 0x86, 0x3c, 0x67, 0x11,
+	0x4b, 0x83, 0x78, 0x25, 0xd8, 0x8e, 0xaa, 0xcb, 0x86, 0x64, 0x50, 0x93, 0x27, 0x6c, 0x47, 0x9d,
+	0x5f, 0x60, 0x34, 0xf6, 0xb1, 0x01, 0xba, 0x3f, 0x5d, 0x1e, 0x2d, 0xfe, 0xae, 0xe6, 0x8b, 0xf9,
+	0x74, 0xd4, 0x3a, 0x20, 0x4e, 0x8f, 0x67, 0x93, 0x11, 0x72, 0xce, 0xa0, 0xe7, 0xf3, 0xf0, 0x32,
+	0xa6, 0xc5, 0xc9, 0x72, 0x9b, 0x51, 0x15, 0xb8, 0x4f, 0x14, 0xc6, 0x3f, 0xaa, 0x8e, 0xcb, 0x22,
+	0x3f, 0xbe, 0x78, 0x44, 0x55, 0x3d, 0x06, 0x2d, 0x0d, 0x12, 0xaa, 0x1a, 0xeb, 0x13, 0x85, 0x9d,
+	0x2b, 0x04, 0xef, 0xfe, 0xb1, 0x98, 0xd6, 0xff, 0xa5, 0x04, 0xc8, 0x6e, 0xd7, 0x82, 0xb7, 0xf8,
+	0x7f, 0x80, 0x6e, 0x4c, 0x03, 0x51, 0x3f, 0x50, 0x0e, 0x85, 0x69, 0xc2, 0x43, 0xaa, 0x7e, 0x64,
+	0x48, 0x14, 0xc6, 0x5f, 0x61, 0x90, 0x48, 0x96, 0xd0, 0x55, 0xc2, 0xd6, 0x39, 0x17, 0x66, 0xd7,
+	0x46, 0x6e, 0x87, 0xe8, 0x8a, 0xf3, 0x15, 0x35, 0xf1, 0x6e, 0xf7, 0x16, 0xba, 0xdf, 0x5b, 0xe8,
+	0x61, 0x6f, 0xa1, 0xeb, 0x47, 0xab, 0x05, 0x5f, 0x78, 0x1e, 0x79, 0x42, 0xb2, 0x34, 0xca, 0x83,
+	0x6d, 0x33, 0xc7, 0xd3, 0x00, 0x14, 0x36, 0x0c, 0xd0, 0x93, 0x02, 0x00, 0x00,
 }
@@ -16,9 +16,24 @@ var _ = fmt.Errorf
 var _ = math.Inf
 
 type RedirectParameters struct {
-	Filename         *string `protobuf:"bytes,1,opt,name=filename" json:"filename,omitempty"`
-	Memory           *bool   `protobuf:"varint,2,opt,name=memory" json:"memory,omitempty"`
-	Buffer           *Blob   `protobuf:"bytes,3,opt,name=buffer" json:"buffer,omitempty"`
+	Filename *string `protobuf:"bytes,1,opt,name=filename" json:"filename,omitempty"`
+	Memory   *bool   `protobuf:"varint,2,opt,name=memory" json:"memory,omitempty"`
+	Buffer   *Blob   `protobuf:"bytes,3,opt,name=buffer" json:"buffer,omitempty"`
+	// With tee, output is written to the filename, and first head and last tail
+	// bytes of it are returned in memory.
+	Head *uint64 `protobuf:"varint,4,opt,name=head" json:"head,omitempty"`
+	Tail *uint64 `protobuf:"varint,5,opt,name=tail" json:"tail,omitempty"`
+	// Linux only. Descriptor is connected to a pseudo-terminal, shared by all descriptors
+	// with this flag. Output is captured to the filename, if set, or to memory; buffer is
+	// typed in as input.
+	Pty *bool `protobuf:"varint,6,opt,name=pty" json:"pty,omitempty"`
+	// Stdin or stdout only. Path inside the sandbox where the service creates a named FIFO or
+	// a unix socket; process is started once an external tool attaches to it. Socket named by
+	// both stdin and stdout is shared. The path is removed after the execution. Can't be
+	// combined with the other fields.
+	Fifo             *string `protobuf:"bytes,7,opt,name=fifo" json:"fifo,omitempty"`
+	Socket           *string `protobuf:"bytes,8,opt,name=socket" json:"socket,omitempty"`
+	Tee              *bool   `protobuf:"varint,9,opt,name=tee" json:"tee,omitempty"`
 	XXX_unrecognized []byte  `json:"-"`
 }
 
@@ -48,6 +63,48 @@ func (m *RedirectParameters) GetBuffer() *Blob {
 	return nil
 }
 
+func (m *RedirectParameters) GetHead() uint64 {
+	if m != nil && m.Head != nil {
+		return *m.Head
+	}
+	return 0
+}
+
+func (m *RedirectParameters) GetTail() uint64 {
+	if m != nil && m.Tail != nil {
+		return *m.Tail
+	}
+	return 0
+}
+
+func (m *RedirectParameters) GetPty() bool {
+	if m != nil && m.Pty != nil {
+		return *m.Pty
+	}
+	return false
+}
+
+func (m *RedirectParameters) GetFifo() string {
+	if m != nil && m.Fifo != nil {
+		return *m.Fifo
+	}
+	return ""
+}
+
+func (m *RedirectParameters) GetSocket() string {
+	if m != nil && m.Socket != nil {
+		return *m.Socket
+	}
+	return ""
+}
+
+func (m *RedirectParameters) GetTee() bool {
+	if m != nil && m.Tee != nil {
+		return *m.Tee
+	}
+	return false
+}
+
 type ExecutionResultFlags struct {
 	Killed             *bool  `protobuf:"varint,1,opt,name=killed" json:"killed,omitempty"`
 	TimeLimitHit       *bool  `protobuf:"varint,2,opt,name=time_limit_hit,json=timeLimitHit" json:"time_limit_hit,omitempty"`
@@ -62,6 +119,7 @@ type ExecutionResultFlags struct {
 	ProcessLimitHit    *bool  `protobuf:"varint,11,opt,name=process_limit_hit,json=processLimitHit" json:"process_limit_hit,omitempty"`
 	StoppedBySignal    *bool  `protobuf:"varint,12,opt,name=stopped_by_signal,json=stoppedBySignal" json:"stopped_by_signal,omitempty"`
 	KilledBySignal     *bool  `protobuf:"varint,13,opt,name=killed_by_signal,json=killedBySignal" json:"killed_by_signal,omitempty"`
+	DiskQuotaExceeded  *bool  `protobuf:"varint,14,opt,name=disk_quota_exceeded,json=diskQuotaExceeded" json:"disk_quota_exceeded,omitempty"`
 	XXX_unrecognized   []byte `json:"-"`
 }
 
@@ -161,6 +219,13 @@ func (m *ExecutionResultFlags) GetKilledBySignal() bool {
 	return false
 }
 
+func (m *ExecutionResultFlags) GetDiskQuotaExceeded() bool {
+	if m != nil && m.DiskQuotaExceeded != nil {
+		return *m.DiskQuotaExceeded
+	}
+	return false
+}
+
 type ExecutionResultTime struct {
 	UserTimeMicros   *uint64 `protobuf:"varint,1,opt,name=user_time_micros,json=userTimeMicros" json:"user_time_micros,omitempty"`
 	KernelTimeMicros *uint64 `protobuf:"varint,2,opt,name=kernel_time_micros,json=kernelTimeMicros" json:"kernel_time_micros,omitempty"`
@@ -240,6 +305,48 @@ func (m *RedirectParameters) MarshalTo(data []byte) (int, error) {
 		}
 		i += n1
 	}
+	if m.Head != nil {
+		data[i] = 0x20
+		i++
+		i = encodeVarintExecution(data, i, uint64(*m.Head))
+	}
+	if m.Tail != nil {
+		data[i] = 0x28
+		i++
+		i = encodeVarintExecution(data, i, uint64(*m.Tail))
+	}
+	if m.Pty != nil {
+		data[i] = 0x30
+		i++
+		if *m.Pty {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Fifo != nil {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintExecution(data, i, uint64(len(*m.Fifo)))
+		i += copy(data[i:], *m.Fifo)
+	}
+	if m.Socket != nil {
+		data[i] = 0x42
+		i++
+		i = encodeVarintExecution(data, i, uint64(len(*m.Socket)))
+		i += copy(data[i:], *m.Socket)
+	}
+	if m.Tee != nil {
+		data[i] = 0x48
+		i++
+		if *m.Tee {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -391,6 +498,16 @@ func (m *ExecutionResultFlags) MarshalTo(data []byte) (int, error) {
 		}
 		i++
 	}
+	if m.DiskQuotaExceeded != nil {
+		data[i] = 0x70
+		i++
+		if *m.DiskQuotaExceeded {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -474,6 +591,26 @@ func (m *RedirectParameters) Size() (n int) {
 		l = m.Buffer.Size()
 		n += 1 + l + sovExecution(uint64(l))
 	}
+	if m.Head != nil {
+		n += 1 + sovExecution(uint64(*m.Head))
+	}
+	if m.Tail != nil {
+		n += 1 + sovExecution(uint64(*m.Tail))
+	}
+	if m.Pty != nil {
+		n += 2
+	}
+	if m.Fifo != nil {
+		l = len(*m.Fifo)
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Socket != nil {
+		l = len(*m.Socket)
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Tee != nil {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -522,6 +659,9 @@ func (m *ExecutionResultFlags) Size() (n int) {
 	if m.KilledBySignal != nil {
 		n += 2
 	}
+	if m.DiskQuotaExceeded != nil {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -672,6 +812,148 @@ func (m *RedirectParameters) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Head = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tail", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tail = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pty", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Pty = &b
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fifo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Fifo = &s
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Socket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Socket = &s
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Tee = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecution(data[iNdEx:])
@@ -996,6 +1278,27 @@ func (m *ExecutionResultFlags) Unmarshal(data []byte) error {
 			}
 			b := bool(v != 0)
 			m.KilledBySignal = &b
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskQuotaExceeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.DiskQuotaExceeded = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecution(data[iNdEx:])
@@ -1235,35 +1538,41 @@ var (
 )
 
 var fileDescriptorExecution = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x5c, 0x92, 0xd1, 0x6e, 0xd3, 0x30,
-	0x14, 0x86, 0xe9, 0x5a, 0x4a, 0xe7, 0x6e, 0x4d, 0xf1, 0x18, 0xb2, 0x26, 0x34, 0xa1, 0x0a, 0xc1,
-	0x84, 0x20, 0x12, 0x3c, 0x42, 0x25, 0x10, 0x17, 0x20, 0xa6, 0xc0, 0x7d, 0xe4, 0x26, 0xa7, 0xc5,
-	0x9a, 0x13, 0x47, 0xb6, 0xb3, 0xd2, 0x27, 0x81, 0x5b, 0xde, 0x86, 0x4b, 0x1e, 0x01, 0xc1, 0x8b,
-	0x70, 0x6c, 0x87, 0x34, 0xcd, 0x45, 0x2e, 0xce, 0xe7, 0xcf, 0xe7, 0xc4, 0xbf, 0x4d, 0xa2, 0x37,
-	0x5f, 0x21, 0xab, 0xad, 0x50, 0x65, 0x5c, 0x69, 0x65, 0x15, 0x8d, 0x32, 0x55, 0x5a, 0x30, 0x16,
-	0x74, 0x00, 0x17, 0xd3, 0xa5, 0x54, 0x2b, 0x13, 0x8a, 0xc5, 0x96, 0xd0, 0x04, 0x72, 0xa1, 0x21,
-	0xb3, 0xd7, 0x5c, 0xf3, 0x02, 0xd0, 0x33, 0xf4, 0x82, 0x4c, 0xd6, 0x42, 0x42, 0x89, 0x35, 0x1b,
-	0x3c, 0x1e, 0x5c, 0x1d, 0x27, 0x6d, 0x4d, 0x1f, 0x92, 0x71, 0x01, 0x85, 0xd2, 0x3b, 0x76, 0x84,
-	0x2b, 0x93, 0xa4, 0xa9, 0xe8, 0x4b, 0x32, 0x5e, 0xd5, 0xeb, 0x35, 0x68, 0x36, 0x44, 0x3e, 0x7d,
-	0x7d, 0x1e, 0xf7, 0x06, 0xc7, 0x6e, 0x6e, 0xd2, 0x48, 0x8b, 0x1f, 0x23, 0xf2, 0xa0, 0xfd, 0xd5,
-	0x04, 0x4c, 0x2d, 0xed, 0x5b, 0xc9, 0x37, 0xc6, 0xf5, 0xbf, 0x11, 0x52, 0x42, 0xee, 0x27, 0x63,
-	0xff, 0x50, 0xd1, 0x27, 0x64, 0x66, 0x45, 0x01, 0xa9, 0x14, 0x85, 0xb0, 0xe9, 0x17, 0x61, 0x9b,
-	0xf9, 0x27, 0x8e, 0xbe, 0x77, 0xf0, 0x9d, 0xb0, 0xf4, 0x8a, 0xcc, 0xc3, 0xff, 0x74, 0xbc, 0xa1,
-	0xf7, 0x66, 0x81, 0xb7, 0x26, 0x9e, 0x51, 0x94, 0x3c, 0xb3, 0xe2, 0x16, 0xd8, 0xc8, 0x1b, 0x6d,
-	0x4d, 0x9f, 0x92, 0xa8, 0x3b, 0x8b, 0xeb, 0x9c, 0xdd, 0xf5, 0xca, 0xe9, 0x7e, 0x18, 0x42, 0xfa,
-	0x8c, 0x44, 0xc6, 0xe6, 0xaa, 0xb6, 0xa9, 0xba, 0x05, 0xbd, 0x96, 0x6a, 0xcb, 0xc6, 0x61, 0x58,
-	0xc0, 0x1f, 0x1b, 0xda, 0x88, 0xa0, 0xf5, 0x5e, 0xbc, 0xd7, 0x8a, 0x88, 0x7b, 0x62, 0x25, 0x2a,
-	0x48, 0xdd, 0x28, 0xec, 0xc1, 0x26, 0xad, 0xe8, 0xf0, 0xe7, 0x40, 0x31, 0xee, 0xb3, 0xc3, 0x38,
-	0xd2, 0x4a, 0x19, 0xcb, 0x8e, 0xbd, 0x3c, 0xef, 0x66, 0x72, 0x8d, 0x9c, 0xbe, 0x22, 0xe7, 0xfd,
-	0x5c, 0xc2, 0x06, 0xe2, 0x37, 0xd0, 0xc3, 0x70, 0xfc, 0x96, 0xe7, 0xe4, 0x3e, 0xde, 0x5b, 0x06,
-	0xc6, 0x74, 0xb2, 0x9c, 0x7a, 0x3d, 0x6a, 0x16, 0xda, 0x30, 0xd1, 0x35, 0x56, 0x55, 0x15, 0xe4,
-	0xe9, 0x6a, 0x97, 0x1a, 0xb1, 0x29, 0xb9, 0x64, 0x27, 0xc1, 0x6d, 0x16, 0x96, 0xbb, 0x4f, 0x1e,
-	0xbb, 0x2b, 0x0a, 0x57, 0xda, 0x51, 0x4f, 0xc3, 0x19, 0x03, 0xff, 0x6f, 0x2e, 0xbe, 0x0d, 0xc8,
-	0x59, 0xef, 0x8d, 0xb8, 0xe3, 0xbb, 0x0e, 0xb5, 0x01, 0xed, 0x13, 0x4a, 0x0b, 0x91, 0x69, 0x65,
-	0xfc, 0x63, 0x19, 0x25, 0x33, 0xc7, 0x9d, 0xf3, 0xc1, 0x53, 0xfa, 0x82, 0xd0, 0x1b, 0xd0, 0x25,
-	0xc8, 0x03, 0xf7, 0xc8, 0xbb, 0xf3, 0xb0, 0xd2, 0xb1, 0xb1, 0xef, 0x96, 0xcb, 0x43, 0x77, 0x18,
-	0xfa, 0x3a, 0xbe, 0x37, 0x97, 0xf1, 0xcf, 0x3f, 0x97, 0x83, 0x5f, 0xf8, 0xfd, 0xc6, 0xef, 0xfb,
-	0xdf, 0xcb, 0x3b, 0xe4, 0x91, 0xd2, 0x9b, 0xd8, 0x58, 0x51, 0x6e, 0x34, 0xdf, 0xf5, 0x9f, 0xfe,
-	0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb8, 0xb5, 0x4f, 0x34, 0x96, 0x03, 0x00, 0x00,
+	// 571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x93, 0x5f, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0x7f, 0x6e, 0xf2, 0x6b, 0xdd, 0x6d, 0x9b, 0xa4, 0x5b, 0x8a, 0xac, 0x0a, 0x45, 0x51,
+	0x85, 0xc0, 0xaa, 0xa8, 0x25, 0x38, 0x42, 0xa5, 0x22, 0x1e, 0x40, 0x14, 0xc3, 0xbb, 0xb5, 0xb5,
+	0xc7, 0xe9, 0x2a, 0x6b, 0xaf, 0xd9, 0x1d, 0xb7, 0xcd, 0x49, 0xe0, 0x48, 0x3c, 0xc2, 0x0d, 0x50,
+	0x38, 0x00, 0x57, 0x40, 0xfb, 0x47, 0x8e, 0x93, 0xb7, 0x99, 0xef, 0x7c, 0xc6, 0xb3, 0xf3, 0xdd,
+	0x35, 0x19, 0x5f, 0x3f, 0x42, 0xde, 0x22, 0x97, 0x75, 0xd2, 0x28, 0x89, 0x92, 0x8e, 0x73, 0x59,
+	0x23, 0x68, 0x04, 0xe5, 0x84, 0xb3, 0x83, 0x2b, 0x21, 0x6f, 0xb5, 0x4b, 0xce, 0xff, 0x06, 0x84,
+	0xa6, 0x50, 0x70, 0x05, 0x39, 0xde, 0x30, 0xc5, 0x2a, 0x40, 0x50, 0x9a, 0x9e, 0x91, 0xb0, 0xe4,
+	0x02, 0x6a, 0x56, 0x41, 0x14, 0xcc, 0x82, 0x78, 0x3f, 0xed, 0x72, 0xfa, 0x94, 0xec, 0x56, 0x50,
+	0x49, 0xb5, 0x8c, 0x76, 0x66, 0x41, 0x1c, 0xa6, 0x3e, 0xa3, 0x97, 0x64, 0xf7, 0xb6, 0x2d, 0x4b,
+	0x50, 0xd1, 0x60, 0x16, 0xc4, 0x07, 0x6f, 0x4e, 0x93, 0xad, 0xc9, 0x89, 0x19, 0x9c, 0x7a, 0x88,
+	0x52, 0x32, 0xbc, 0x03, 0x56, 0x44, 0xc3, 0x59, 0x10, 0x0f, 0x53, 0x1b, 0x1b, 0x0d, 0x19, 0x17,
+	0xd1, 0xff, 0x4e, 0x33, 0x31, 0x9d, 0x90, 0x41, 0x83, 0xcb, 0x68, 0xd7, 0xce, 0x32, 0xa1, 0xa1,
+	0x4a, 0x5e, 0xca, 0x68, 0xcf, 0x1e, 0xcc, 0xc6, 0xe6, 0x50, 0x5a, 0xe6, 0x0b, 0xc0, 0x28, 0xb4,
+	0xaa, 0xcf, 0x4c, 0x37, 0x02, 0x44, 0xfb, 0xae, 0x1b, 0x01, 0xce, 0x7f, 0x0d, 0xc9, 0x93, 0xce,
+	0xa3, 0x14, 0x74, 0x2b, 0xf0, 0xad, 0x60, 0x73, 0x6d, 0x3e, 0xb1, 0xe0, 0x42, 0x40, 0x61, 0x37,
+	0x0e, 0x53, 0x9f, 0xd1, 0xe7, 0x64, 0x84, 0xbc, 0x82, 0x4c, 0xf0, 0x8a, 0x63, 0x76, 0xc7, 0xd1,
+	0xef, 0x7d, 0x68, 0xd4, 0xf7, 0x46, 0x7c, 0xc7, 0x91, 0xc6, 0x64, 0xe2, 0x7c, 0xe8, 0x71, 0x03,
+	0xcb, 0x8d, 0x9c, 0xde, 0x91, 0x67, 0x24, 0xe4, 0x35, 0xcb, 0x91, 0xdf, 0x83, 0x5d, 0x3e, 0x4c,
+	0xbb, 0x9c, 0xbe, 0x20, 0xe3, 0xfe, 0x2c, 0xa6, 0x0a, 0xeb, 0x45, 0x98, 0x1e, 0xad, 0x87, 0x31,
+	0x55, 0xd0, 0x97, 0x64, 0xac, 0xb1, 0x90, 0x2d, 0x66, 0xf2, 0x1e, 0x54, 0x29, 0xe4, 0x83, 0x37,
+	0x68, 0xe4, 0xe4, 0x8f, 0x5e, 0xf5, 0x20, 0x28, 0xb5, 0x06, 0xf7, 0x3a, 0x10, 0x94, 0xda, 0x02,
+	0x1b, 0xde, 0x40, 0x66, 0x46, 0xc9, 0xd6, 0x39, 0xe9, 0x40, 0x23, 0x7f, 0x71, 0x2a, 0xbd, 0x24,
+	0x27, 0x9b, 0x76, 0x64, 0x8d, 0xd4, 0xe8, 0x1d, 0x9e, 0xf4, 0x3d, 0xb9, 0x91, 0x1a, 0xe9, 0x6b,
+	0x72, 0xba, 0xed, 0x8b, 0x6b, 0x20, 0xb6, 0x81, 0x6e, 0x9a, 0x63, 0x5b, 0x2e, 0xc8, 0x71, 0xa3,
+	0x64, 0x0e, 0x5a, 0xf7, 0xbc, 0x3c, 0xb0, 0xf8, 0xd8, 0x17, 0x3a, 0x33, 0x2f, 0xc8, 0xb1, 0x46,
+	0xd9, 0x34, 0x50, 0x64, 0xb7, 0xcb, 0x4c, 0xf3, 0x79, 0xcd, 0x44, 0x74, 0xe8, 0x58, 0x5f, 0xb8,
+	0x5a, 0x7e, 0xb6, 0xb2, 0xb9, 0x22, 0x77, 0xa5, 0x3d, 0xf4, 0xc8, 0xed, 0xe8, 0xf4, 0x8e, 0x4c,
+	0xc8, 0x49, 0xc1, 0xf5, 0x22, 0xfb, 0xda, 0x4a, 0x64, 0x19, 0x3c, 0xe6, 0x00, 0x05, 0x14, 0xd1,
+	0xc8, 0xc2, 0xc7, 0xa6, 0xf4, 0xc9, 0x54, 0xae, 0x7d, 0xe1, 0xfc, 0x5b, 0x40, 0x4e, 0xb6, 0xde,
+	0x94, 0xb1, 0xcb, 0x4c, 0x6c, 0x35, 0x28, 0xeb, 0x68, 0x56, 0xf1, 0x5c, 0x49, 0x6d, 0x1f, 0xd7,
+	0x30, 0x1d, 0x19, 0xdd, 0x30, 0x1f, 0xac, 0x4a, 0x5f, 0x11, 0xba, 0x00, 0x55, 0x83, 0xd8, 0x60,
+	0x77, 0x2c, 0x3b, 0x71, 0x95, 0x1e, 0x1d, 0x93, 0xc9, 0x03, 0x13, 0x9b, 0xec, 0xc0, 0x7d, 0xd7,
+	0xe8, 0x6b, 0xf2, 0x2a, 0xf9, 0xb1, 0x9a, 0x06, 0x3f, 0x57, 0xd3, 0xe0, 0xf7, 0x6a, 0x1a, 0x7c,
+	0xff, 0x33, 0xfd, 0x8f, 0x3c, 0x93, 0x6a, 0x9e, 0x68, 0xe4, 0xf5, 0x5c, 0xb1, 0xe5, 0xf6, 0x2f,
+	0xfa, 0x6f, 0x00, 0x94, 0xa4, 0x71, 0x1f, 0x3f, 0x04, 0x00, 0x00,
 }
@@ -17,6 +17,79 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+type ArchiveFormat int32
+
+const (
+	ArchiveFormat_TAR ArchiveFormat = 0
+	ArchiveFormat_ZIP ArchiveFormat = 1
+)
+
+var ArchiveFormat_name = map[int32]string{
+	0: "TAR",
+	1: "ZIP",
+}
+var ArchiveFormat_value = map[string]int32{
+	"TAR": 0,
+	"ZIP": 1,
+}
+
+func (x ArchiveFormat) Enum() *ArchiveFormat {
+	p := new(ArchiveFormat)
+	*p = x
+	return p
+}
+func (x ArchiveFormat) String() string {
+	return proto.EnumName(ArchiveFormat_name, int32(x))
+}
+func (x *ArchiveFormat) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(ArchiveFormat_value, data, "ArchiveFormat")
+	if err != nil {
+		return err
+	}
+	*x = ArchiveFormat(value)
+	return nil
+}
+func (ArchiveFormat) EnumDescriptor() ([]byte, []int) { return fileDescriptorLocal, []int{0} }
+
+type LocalExecutionParameters_RepeatPolicy int32
+
+const (
+	LocalExecutionParameters_WORST    LocalExecutionParameters_RepeatPolicy = 0
+	LocalExecutionParameters_BEST     LocalExecutionParameters_RepeatPolicy = 1
+	LocalExecutionParameters_MAJORITY LocalExecutionParameters_RepeatPolicy = 2
+)
+
+var LocalExecutionParameters_RepeatPolicy_name = map[int32]string{
+	0: "WORST",
+	1: "BEST",
+	2: "MAJORITY",
+}
+var LocalExecutionParameters_RepeatPolicy_value = map[string]int32{
+	"WORST":    0,
+	"BEST":     1,
+	"MAJORITY": 2,
+}
+
+func (x LocalExecutionParameters_RepeatPolicy) Enum() *LocalExecutionParameters_RepeatPolicy {
+	p := new(LocalExecutionParameters_RepeatPolicy)
+	*p = x
+	return p
+}
+func (x LocalExecutionParameters_RepeatPolicy) String() string {
+	return proto.EnumName(LocalExecutionParameters_RepeatPolicy_name, int32(x))
+}
+func (x *LocalExecutionParameters_RepeatPolicy) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(LocalExecutionParameters_RepeatPolicy_value, data, "LocalExecutionParameters_RepeatPolicy")
+	if err != nil {
+		return err
+	}
+	*x = LocalExecutionParameters_RepeatPolicy(value)
+	return nil
+}
+func (LocalExecutionParameters_RepeatPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorLocal, []int{1, 0}
+}
+
 type BinaryTypeResponse_Win32BinaryType int32
 
 const (
@@ -65,8 +138,45 @@ func (x *BinaryTypeResponse_Win32BinaryType) UnmarshalJSON(data []byte) error {
 	return nil
 }
 func (BinaryTypeResponse_Win32BinaryType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorLocal, []int{7, 0}
+	return fileDescriptorLocal, []int{11, 0}
+}
+
+type CopyResult_Status int32
+
+const (
+	CopyResult_OK      CopyResult_Status = 0
+	CopyResult_FAILED  CopyResult_Status = 1
+	CopyResult_SKIPPED CopyResult_Status = 2
+)
+
+var CopyResult_Status_name = map[int32]string{
+	0: "OK",
+	1: "FAILED",
+	2: "SKIPPED",
+}
+var CopyResult_Status_value = map[string]int32{
+	"OK":      0,
+	"FAILED":  1,
+	"SKIPPED": 2,
+}
+
+func (x CopyResult_Status) Enum() *CopyResult_Status {
+	p := new(CopyResult_Status)
+	*p = x
+	return p
+}
+func (x CopyResult_Status) String() string {
+	return proto.EnumName(CopyResult_Status_name, int32(x))
+}
+func (x *CopyResult_Status) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(CopyResult_Status_value, data, "CopyResult_Status")
+	if err != nil {
+		return err
+	}
+	*x = CopyResult_Status(value)
+	return nil
 }
+func (CopyResult_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptorLocal, []int{38, 0} }
 
 type LocalEnvironment struct {
 	Empty            *bool                        `protobuf:"varint,1,opt,name=empty" json:"empty,omitempty"`
@@ -146,7 +256,20 @@ type LocalExecutionParameters struct {
 	CommandLineParameters []string            `protobuf:"bytes,16,rep,name=command_line_parameters,json=commandLineParameters" json:"command_line_parameters,omitempty"`
 	SandboxId             *string             `protobuf:"bytes,17,opt,name=sandbox_id,json=sandboxId" json:"sandbox_id,omitempty"`
 	JoinStdoutStderr      *bool               `protobuf:"varint,18,opt,name=join_stdout_stderr,json=joinStdoutStderr" json:"join_stdout_stderr,omitempty"`
-	XXX_unrecognized      []byte              `json:"-"`
+	// Run the process this many times; result is the run chosen by repeat_policy,
+	// with statistics over all runs. Input can't be streamed from a FIFO or socket.
+	Repeat       *uint32                                `protobuf:"varint,19,opt,name=repeat" json:"repeat,omitempty"`
+	RepeatPolicy *LocalExecutionParameters_RepeatPolicy `protobuf:"varint,20,opt,name=repeat_policy,json=repeatPolicy,enum=contester.proto.LocalExecutionParameters_RepeatPolicy" json:"repeat_policy,omitempty"`
+	// Time limits are given for the reference host, and are scaled by the speed factor
+	// of this one. Result has normalized_time in addition to the measured one.
+	NormalizeTime *bool `protobuf:"varint,21,opt,name=normalize_time,json=normalizeTime" json:"normalize_time,omitempty"`
+	// Look up application_name (or the first argument) in PATH of the environment.
+	// On Linux, command_line is split with shell quoting rules if there are no
+	// command_line_parameters.
+	SearchPath *bool `protobuf:"varint,22,opt,name=search_path,json=searchPath" json:"search_path,omitempty"`
+	// Token of the sandbox lease, required if the sandbox pair is leased.
+	Lease            *string `protobuf:"bytes,23,opt,name=lease" json:"lease,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *LocalExecutionParameters) Reset()                    { *m = LocalExecutionParameters{} }
@@ -273,10 +396,47 @@ func (m *LocalExecutionParameters) GetJoinStdoutStderr() bool {
 	return false
 }
 
+func (m *LocalExecutionParameters) GetRepeat() uint32 {
+	if m != nil && m.Repeat != nil {
+		return *m.Repeat
+	}
+	return 0
+}
+
+func (m *LocalExecutionParameters) GetRepeatPolicy() LocalExecutionParameters_RepeatPolicy {
+	if m != nil && m.RepeatPolicy != nil {
+		return *m.RepeatPolicy
+	}
+	return LocalExecutionParameters_WORST
+}
+
+func (m *LocalExecutionParameters) GetNormalizeTime() bool {
+	if m != nil && m.NormalizeTime != nil {
+		return *m.NormalizeTime
+	}
+	return false
+}
+
+func (m *LocalExecutionParameters) GetSearchPath() bool {
+	if m != nil && m.SearchPath != nil {
+		return *m.SearchPath
+	}
+	return false
+}
+
+func (m *LocalExecutionParameters) GetLease() string {
+	if m != nil && m.Lease != nil {
+		return *m.Lease
+	}
+	return ""
+}
+
 type LocalExecuteConnected struct {
-	First            *LocalExecutionParameters `protobuf:"bytes,1,opt,name=first" json:"first,omitempty"`
-	Second           *LocalExecutionParameters `protobuf:"bytes,2,opt,name=second" json:"second,omitempty"`
-	XXX_unrecognized []byte                    `json:"-"`
+	First  *LocalExecutionParameters `protobuf:"bytes,1,opt,name=first" json:"first,omitempty"`
+	Second *LocalExecutionParameters `protobuf:"bytes,2,opt,name=second" json:"second,omitempty"`
+	// Record timestamped interaction between the processes into the result.
+	RecordTranscript *bool  `protobuf:"varint,3,opt,name=record_transcript,json=recordTranscript" json:"record_transcript,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *LocalExecuteConnected) Reset()                    { *m = LocalExecuteConnected{} }
@@ -298,17 +458,32 @@ func (m *LocalExecuteConnected) GetSecond() *LocalExecutionParameters {
 	return nil
 }
 
+func (m *LocalExecuteConnected) GetRecordTranscript() bool {
+	if m != nil && m.RecordTranscript != nil {
+		return *m.RecordTranscript
+	}
+	return false
+}
+
 type LocalExecutionResult struct {
-	Flags            *ExecutionResultFlags `protobuf:"bytes,1,opt,name=flags" json:"flags,omitempty"`
-	Time             *ExecutionResultTime  `protobuf:"bytes,2,opt,name=time" json:"time,omitempty"`
-	Memory           *uint64               `protobuf:"varint,3,opt,name=memory" json:"memory,omitempty"`
-	ReturnCode       *uint32               `protobuf:"varint,4,opt,name=return_code,json=returnCode" json:"return_code,omitempty"`
-	StdOut           *Blob                 `protobuf:"bytes,5,opt,name=std_out,json=stdOut" json:"std_out,omitempty"`
-	StdErr           *Blob                 `protobuf:"bytes,6,opt,name=std_err,json=stdErr" json:"std_err,omitempty"`
-	TotalProcesses   *uint64               `protobuf:"varint,7,opt,name=total_processes,json=totalProcesses" json:"total_processes,omitempty"`
-	KillSignal       *int32                `protobuf:"varint,8,opt,name=kill_signal,json=killSignal" json:"kill_signal,omitempty"`
-	StopSignal       *int32                `protobuf:"varint,9,opt,name=stop_signal,json=stopSignal" json:"stop_signal,omitempty"`
-	XXX_unrecognized []byte                `json:"-"`
+	Flags          *ExecutionResultFlags `protobuf:"bytes,1,opt,name=flags" json:"flags,omitempty"`
+	Time           *ExecutionResultTime  `protobuf:"bytes,2,opt,name=time" json:"time,omitempty"`
+	Memory         *uint64               `protobuf:"varint,3,opt,name=memory" json:"memory,omitempty"`
+	ReturnCode     *uint32               `protobuf:"varint,4,opt,name=return_code,json=returnCode" json:"return_code,omitempty"`
+	StdOut         *Blob                 `protobuf:"bytes,5,opt,name=std_out,json=stdOut" json:"std_out,omitempty"`
+	StdErr         *Blob                 `protobuf:"bytes,6,opt,name=std_err,json=stdErr" json:"std_err,omitempty"`
+	TotalProcesses *uint64               `protobuf:"varint,7,opt,name=total_processes,json=totalProcesses" json:"total_processes,omitempty"`
+	KillSignal     *int32                `protobuf:"varint,8,opt,name=kill_signal,json=killSignal" json:"kill_signal,omitempty"`
+	StopSignal     *int32                `protobuf:"varint,9,opt,name=stop_signal,json=stopSignal" json:"stop_signal,omitempty"`
+	// Set if std_out/std_err only contain head and tail of the output.
+	StdOutTruncated  *bool             `protobuf:"varint,10,opt,name=std_out_truncated,json=stdOutTruncated" json:"std_out_truncated,omitempty"`
+	StdErrTruncated  *bool             `protobuf:"varint,11,opt,name=std_err_truncated,json=stdErrTruncated" json:"std_err_truncated,omitempty"`
+	RepeatStatistics *RepeatStatistics `protobuf:"bytes,12,opt,name=repeat_statistics,json=repeatStatistics" json:"repeat_statistics,omitempty"`
+	// Time converted to the reference host, if normalize_time was requested.
+	NormalizedTime *ExecutionResultTime `protobuf:"bytes,13,opt,name=normalized_time,json=normalizedTime" json:"normalized_time,omitempty"`
+	// Set instead of the other fields if the process couldn't be started.
+	SetupFailure     *SetupFailure `protobuf:"bytes,14,opt,name=setup_failure,json=setupFailure" json:"setup_failure,omitempty"`
+	XXX_unrecognized []byte        `json:"-"`
 }
 
 func (m *LocalExecutionResult) Reset()                    { *m = LocalExecutionResult{} }
@@ -379,16 +554,179 @@ func (m *LocalExecutionResult) GetStopSignal() int32 {
 	return 0
 }
 
+func (m *LocalExecutionResult) GetStdOutTruncated() bool {
+	if m != nil && m.StdOutTruncated != nil {
+		return *m.StdOutTruncated
+	}
+	return false
+}
+
+func (m *LocalExecutionResult) GetStdErrTruncated() bool {
+	if m != nil && m.StdErrTruncated != nil {
+		return *m.StdErrTruncated
+	}
+	return false
+}
+
+func (m *LocalExecutionResult) GetRepeatStatistics() *RepeatStatistics {
+	if m != nil {
+		return m.RepeatStatistics
+	}
+	return nil
+}
+
+func (m *LocalExecutionResult) GetNormalizedTime() *ExecutionResultTime {
+	if m != nil {
+		return m.NormalizedTime
+	}
+	return nil
+}
+
+func (m *LocalExecutionResult) GetSetupFailure() *SetupFailure {
+	if m != nil {
+		return m.SetupFailure
+	}
+	return nil
+}
+
+type SetupFailure struct {
+	Stage *string `protobuf:"bytes,1,opt,name=stage" json:"stage,omitempty"`
+	Errno *uint32 `protobuf:"varint,2,opt,name=errno" json:"errno,omitempty"`
+	Path  *string `protobuf:"bytes,3,opt,name=path" json:"path,omitempty"`
+	// Caused by the request (e.g. missing binary or directory), not by the invoker.
+	UserError        *bool   `protobuf:"varint,4,opt,name=user_error,json=userError" json:"user_error,omitempty"`
+	Message          *string `protobuf:"bytes,5,opt,name=message" json:"message,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *SetupFailure) Reset()                    { *m = SetupFailure{} }
+func (m *SetupFailure) String() string            { return proto.CompactTextString(m) }
+func (*SetupFailure) ProtoMessage()               {}
+func (*SetupFailure) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{4} }
+
+func (m *SetupFailure) GetStage() string {
+	if m != nil && m.Stage != nil {
+		return *m.Stage
+	}
+	return ""
+}
+
+func (m *SetupFailure) GetErrno() uint32 {
+	if m != nil && m.Errno != nil {
+		return *m.Errno
+	}
+	return 0
+}
+
+func (m *SetupFailure) GetPath() string {
+	if m != nil && m.Path != nil {
+		return *m.Path
+	}
+	return ""
+}
+
+func (m *SetupFailure) GetUserError() bool {
+	if m != nil && m.UserError != nil {
+		return *m.UserError
+	}
+	return false
+}
+
+func (m *SetupFailure) GetMessage() string {
+	if m != nil && m.Message != nil {
+		return *m.Message
+	}
+	return ""
+}
+
+type RepeatStatistics struct {
+	Runs             *uint32                 `protobuf:"varint,1,opt,name=runs" json:"runs,omitempty"`
+	UserTimeMicros   *RepeatStatistics_Range `protobuf:"bytes,2,opt,name=user_time_micros,json=userTimeMicros" json:"user_time_micros,omitempty"`
+	WallTimeMicros   *RepeatStatistics_Range `protobuf:"bytes,3,opt,name=wall_time_micros,json=wallTimeMicros" json:"wall_time_micros,omitempty"`
+	Memory           *RepeatStatistics_Range `protobuf:"bytes,4,opt,name=memory" json:"memory,omitempty"`
+	XXX_unrecognized []byte                  `json:"-"`
+}
+
+func (m *RepeatStatistics) Reset()                    { *m = RepeatStatistics{} }
+func (m *RepeatStatistics) String() string            { return proto.CompactTextString(m) }
+func (*RepeatStatistics) ProtoMessage()               {}
+func (*RepeatStatistics) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{5} }
+
+func (m *RepeatStatistics) GetRuns() uint32 {
+	if m != nil && m.Runs != nil {
+		return *m.Runs
+	}
+	return 0
+}
+
+func (m *RepeatStatistics) GetUserTimeMicros() *RepeatStatistics_Range {
+	if m != nil {
+		return m.UserTimeMicros
+	}
+	return nil
+}
+
+func (m *RepeatStatistics) GetWallTimeMicros() *RepeatStatistics_Range {
+	if m != nil {
+		return m.WallTimeMicros
+	}
+	return nil
+}
+
+func (m *RepeatStatistics) GetMemory() *RepeatStatistics_Range {
+	if m != nil {
+		return m.Memory
+	}
+	return nil
+}
+
+type RepeatStatistics_Range struct {
+	Min              *uint64 `protobuf:"varint,1,opt,name=min" json:"min,omitempty"`
+	Median           *uint64 `protobuf:"varint,2,opt,name=median" json:"median,omitempty"`
+	Max              *uint64 `protobuf:"varint,3,opt,name=max" json:"max,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *RepeatStatistics_Range) Reset()                    { *m = RepeatStatistics_Range{} }
+func (m *RepeatStatistics_Range) String() string            { return proto.CompactTextString(m) }
+func (*RepeatStatistics_Range) ProtoMessage()               {}
+func (*RepeatStatistics_Range) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{5, 0} }
+
+func (m *RepeatStatistics_Range) GetMin() uint64 {
+	if m != nil && m.Min != nil {
+		return *m.Min
+	}
+	return 0
+}
+
+func (m *RepeatStatistics_Range) GetMedian() uint64 {
+	if m != nil && m.Median != nil {
+		return *m.Median
+	}
+	return 0
+}
+
+func (m *RepeatStatistics_Range) GetMax() uint64 {
+	if m != nil && m.Max != nil {
+		return *m.Max
+	}
+	return 0
+}
+
 type LocalExecuteConnectedResult struct {
-	First            *LocalExecutionResult `protobuf:"bytes,1,opt,name=first" json:"first,omitempty"`
-	Second           *LocalExecutionResult `protobuf:"bytes,2,opt,name=second" json:"second,omitempty"`
-	XXX_unrecognized []byte                `json:"-"`
+	First  *LocalExecutionResult `protobuf:"bytes,1,opt,name=first" json:"first,omitempty"`
+	Second *LocalExecutionResult `protobuf:"bytes,2,opt,name=second" json:"second,omitempty"`
+	// JSON lines, see subprocess.TranscriptEntry; directions are relative to the first process.
+	Transcript *Blob `protobuf:"bytes,3,opt,name=transcript" json:"transcript,omitempty"`
+	// Set if the transcript hit the size limit and misses the rest of the interaction.
+	TranscriptTruncated *bool  `protobuf:"varint,4,opt,name=transcript_truncated,json=transcriptTruncated" json:"transcript_truncated,omitempty"`
+	XXX_unrecognized    []byte `json:"-"`
 }
 
 func (m *LocalExecuteConnectedResult) Reset()                    { *m = LocalExecuteConnectedResult{} }
 func (m *LocalExecuteConnectedResult) String() string            { return proto.CompactTextString(m) }
 func (*LocalExecuteConnectedResult) ProtoMessage()               {}
-func (*LocalExecuteConnectedResult) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{4} }
+func (*LocalExecuteConnectedResult) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{6} }
 
 func (m *LocalExecuteConnectedResult) GetFirst() *LocalExecutionResult {
 	if m != nil {
@@ -404,6 +742,133 @@ func (m *LocalExecuteConnectedResult) GetSecond() *LocalExecutionResult {
 	return nil
 }
 
+func (m *LocalExecuteConnectedResult) GetTranscript() *Blob {
+	if m != nil {
+		return m.Transcript
+	}
+	return nil
+}
+
+func (m *LocalExecuteConnectedResult) GetTranscriptTruncated() bool {
+	if m != nil && m.TranscriptTruncated != nil {
+		return *m.TranscriptTruncated
+	}
+	return false
+}
+
+// Arbitrary graph of processes, connected by pipes.
+type LocalExecuteGraph struct {
+	Processes        []*LocalExecutionParameters `protobuf:"bytes,1,rep,name=processes" json:"processes,omitempty"`
+	Edges            []*LocalExecuteGraph_Edge   `protobuf:"bytes,2,rep,name=edges" json:"edges,omitempty"`
+	XXX_unrecognized []byte                      `json:"-"`
+}
+
+func (m *LocalExecuteGraph) Reset()                    { *m = LocalExecuteGraph{} }
+func (m *LocalExecuteGraph) String() string            { return proto.CompactTextString(m) }
+func (*LocalExecuteGraph) ProtoMessage()               {}
+func (*LocalExecuteGraph) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{7} }
+
+func (m *LocalExecuteGraph) GetProcesses() []*LocalExecutionParameters {
+	if m != nil {
+		return m.Processes
+	}
+	return nil
+}
+
+func (m *LocalExecuteGraph) GetEdges() []*LocalExecuteGraph_Edge {
+	if m != nil {
+		return m.Edges
+	}
+	return nil
+}
+
+// Connects output descriptor (1 - stdout, 2 - stderr) of the source process
+// to the input descriptor (0 - stdin) of the destination process.
+type LocalExecuteGraph_Edge struct {
+	Source        *uint32 `protobuf:"varint,1,opt,name=source" json:"source,omitempty"`
+	SourceFd      *uint32 `protobuf:"varint,2,opt,name=source_fd,json=sourceFd" json:"source_fd,omitempty"`
+	Destination   *uint32 `protobuf:"varint,3,opt,name=destination" json:"destination,omitempty"`
+	DestinationFd *uint32 `protobuf:"varint,4,opt,name=destination_fd,json=destinationFd" json:"destination_fd,omitempty"`
+	// Data passing through the edge is recorded in the transcript of the result.
+	Record           *bool  `protobuf:"varint,5,opt,name=record" json:"record,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *LocalExecuteGraph_Edge) Reset()                    { *m = LocalExecuteGraph_Edge{} }
+func (m *LocalExecuteGraph_Edge) String() string            { return proto.CompactTextString(m) }
+func (*LocalExecuteGraph_Edge) ProtoMessage()               {}
+func (*LocalExecuteGraph_Edge) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{7, 0} }
+
+func (m *LocalExecuteGraph_Edge) GetSource() uint32 {
+	if m != nil && m.Source != nil {
+		return *m.Source
+	}
+	return 0
+}
+
+func (m *LocalExecuteGraph_Edge) GetSourceFd() uint32 {
+	if m != nil && m.SourceFd != nil {
+		return *m.SourceFd
+	}
+	return 0
+}
+
+func (m *LocalExecuteGraph_Edge) GetDestination() uint32 {
+	if m != nil && m.Destination != nil {
+		return *m.Destination
+	}
+	return 0
+}
+
+func (m *LocalExecuteGraph_Edge) GetDestinationFd() uint32 {
+	if m != nil && m.DestinationFd != nil {
+		return *m.DestinationFd
+	}
+	return 0
+}
+
+func (m *LocalExecuteGraph_Edge) GetRecord() bool {
+	if m != nil && m.Record != nil {
+		return *m.Record
+	}
+	return false
+}
+
+type LocalExecuteGraphResult struct {
+	Results []*LocalExecutionResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+	// JSON lines of the recorded edges, see subprocess.TranscriptEntry; the direction is
+	// the index of the edge.
+	Transcript          *Blob  `protobuf:"bytes,2,opt,name=transcript" json:"transcript,omitempty"`
+	TranscriptTruncated *bool  `protobuf:"varint,3,opt,name=transcript_truncated,json=transcriptTruncated" json:"transcript_truncated,omitempty"`
+	XXX_unrecognized    []byte `json:"-"`
+}
+
+func (m *LocalExecuteGraphResult) Reset()                    { *m = LocalExecuteGraphResult{} }
+func (m *LocalExecuteGraphResult) String() string            { return proto.CompactTextString(m) }
+func (*LocalExecuteGraphResult) ProtoMessage()               {}
+func (*LocalExecuteGraphResult) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{8} }
+
+func (m *LocalExecuteGraphResult) GetResults() []*LocalExecutionResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *LocalExecuteGraphResult) GetTranscript() *Blob {
+	if m != nil {
+		return m.Transcript
+	}
+	return nil
+}
+
+func (m *LocalExecuteGraphResult) GetTranscriptTruncated() bool {
+	if m != nil && m.TranscriptTruncated != nil {
+		return *m.TranscriptTruncated
+	}
+	return false
+}
+
 type LocalExecution struct {
 	Parameters       *LocalExecutionParameters `protobuf:"bytes,1,req,name=parameters" json:"parameters,omitempty"`
 	Result           *LocalExecutionResult     `protobuf:"bytes,2,opt,name=result" json:"result,omitempty"`
@@ -413,7 +878,7 @@ type LocalExecution struct {
 func (m *LocalExecution) Reset()                    { *m = LocalExecution{} }
 func (m *LocalExecution) String() string            { return proto.CompactTextString(m) }
 func (*LocalExecution) ProtoMessage()               {}
-func (*LocalExecution) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{5} }
+func (*LocalExecution) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{9} }
 
 func (m *LocalExecution) GetParameters() *LocalExecutionParameters {
 	if m != nil {
@@ -437,7 +902,7 @@ type BinaryTypeRequest struct {
 func (m *BinaryTypeRequest) Reset()                    { *m = BinaryTypeRequest{} }
 func (m *BinaryTypeRequest) String() string            { return proto.CompactTextString(m) }
 func (*BinaryTypeRequest) ProtoMessage()               {}
-func (*BinaryTypeRequest) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{6} }
+func (*BinaryTypeRequest) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{10} }
 
 func (m *BinaryTypeRequest) GetPathname() string {
 	if m != nil && m.Pathname != nil {
@@ -455,7 +920,7 @@ type BinaryTypeResponse struct {
 func (m *BinaryTypeResponse) Reset()                    { *m = BinaryTypeResponse{} }
 func (m *BinaryTypeResponse) String() string            { return proto.CompactTextString(m) }
 func (*BinaryTypeResponse) ProtoMessage()               {}
-func (*BinaryTypeResponse) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{7} }
+func (*BinaryTypeResponse) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{11} }
 
 func (m *BinaryTypeResponse) GetFailure() bool {
 	if m != nil && m.Failure != nil {
@@ -473,13 +938,14 @@ func (m *BinaryTypeResponse) GetResult() BinaryTypeResponse_Win32BinaryType {
 
 type ClearSandboxRequest struct {
 	Sandbox          *string `protobuf:"bytes,1,opt,name=sandbox" json:"sandbox,omitempty"`
+	Lease            *string `protobuf:"bytes,2,opt,name=lease" json:"lease,omitempty"`
 	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *ClearSandboxRequest) Reset()                    { *m = ClearSandboxRequest{} }
 func (m *ClearSandboxRequest) String() string            { return proto.CompactTextString(m) }
 func (*ClearSandboxRequest) ProtoMessage()               {}
-func (*ClearSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{8} }
+func (*ClearSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{12} }
 
 func (m *ClearSandboxRequest) GetSandbox() string {
 	if m != nil && m.Sandbox != nil {
@@ -488,49 +954,230 @@ func (m *ClearSandboxRequest) GetSandbox() string {
 	return ""
 }
 
-type IdentifyRequest struct {
-	ContesterId      *string `protobuf:"bytes,1,opt,name=contester_id,json=contesterId" json:"contester_id,omitempty"`
-	MongoHost        *string `protobuf:"bytes,2,opt,name=mongo_host,json=mongoHost" json:"mongo_host,omitempty"`
-	MongoDb          *string `protobuf:"bytes,3,opt,name=mongo_db,json=mongoDb" json:"mongo_db,omitempty"`
+func (m *ClearSandboxRequest) GetLease() string {
+	if m != nil && m.Lease != nil {
+		return *m.Lease
+	}
+	return ""
+}
+
+type AcquireSandboxRequest struct {
+	// Any free pair if not set.
+	Index *uint32 `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
+	// Default is 5 minutes, at most an hour. Expired leases are cleared.
+	DurationMicros   *uint64 `protobuf:"varint,2,opt,name=duration_micros,json=durationMicros" json:"duration_micros,omitempty"`
 	XXX_unrecognized []byte  `json:"-"`
 }
 
-func (m *IdentifyRequest) Reset()                    { *m = IdentifyRequest{} }
-func (m *IdentifyRequest) String() string            { return proto.CompactTextString(m) }
-func (*IdentifyRequest) ProtoMessage()               {}
-func (*IdentifyRequest) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{9} }
+func (m *AcquireSandboxRequest) Reset()                    { *m = AcquireSandboxRequest{} }
+func (m *AcquireSandboxRequest) String() string            { return proto.CompactTextString(m) }
+func (*AcquireSandboxRequest) ProtoMessage()               {}
+func (*AcquireSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{13} }
 
-func (m *IdentifyRequest) GetContesterId() string {
-	if m != nil && m.ContesterId != nil {
-		return *m.ContesterId
+func (m *AcquireSandboxRequest) GetIndex() uint32 {
+	if m != nil && m.Index != nil {
+		return *m.Index
 	}
-	return ""
+	return 0
 }
 
-func (m *IdentifyRequest) GetMongoHost() string {
-	if m != nil && m.MongoHost != nil {
-		return *m.MongoHost
+func (m *AcquireSandboxRequest) GetDurationMicros() uint64 {
+	if m != nil && m.DurationMicros != nil {
+		return *m.DurationMicros
 	}
-	return ""
+	return 0
 }
 
-func (m *IdentifyRequest) GetMongoDb() string {
-	if m != nil && m.MongoDb != nil {
-		return *m.MongoDb
-	}
-	return ""
+type SandboxLease struct {
+	Index             *uint32 `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
+	Token             *string `protobuf:"bytes,2,opt,name=token" json:"token,omitempty"`
+	ExpiresUnixMicros *uint64 `protobuf:"varint,3,opt,name=expires_unix_micros,json=expiresUnixMicros" json:"expires_unix_micros,omitempty"`
+	XXX_unrecognized  []byte  `json:"-"`
 }
 
-type SandboxLocations struct {
-	Compile          *string `protobuf:"bytes,1,opt,name=compile" json:"compile,omitempty"`
-	Run              *string `protobuf:"bytes,2,opt,name=run" json:"run,omitempty"`
+func (m *SandboxLease) Reset()                    { *m = SandboxLease{} }
+func (m *SandboxLease) String() string            { return proto.CompactTextString(m) }
+func (*SandboxLease) ProtoMessage()               {}
+func (*SandboxLease) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{14} }
+
+func (m *SandboxLease) GetIndex() uint32 {
+	if m != nil && m.Index != nil {
+		return *m.Index
+	}
+	return 0
+}
+
+func (m *SandboxLease) GetToken() string {
+	if m != nil && m.Token != nil {
+		return *m.Token
+	}
+	return ""
+}
+
+func (m *SandboxLease) GetExpiresUnixMicros() uint64 {
+	if m != nil && m.ExpiresUnixMicros != nil {
+		return *m.ExpiresUnixMicros
+	}
+	return 0
+}
+
+type RenewLeaseRequest struct {
+	Token            *string `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	DurationMicros   *uint64 `protobuf:"varint,2,opt,name=duration_micros,json=durationMicros" json:"duration_micros,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *RenewLeaseRequest) Reset()                    { *m = RenewLeaseRequest{} }
+func (m *RenewLeaseRequest) String() string            { return proto.CompactTextString(m) }
+func (*RenewLeaseRequest) ProtoMessage()               {}
+func (*RenewLeaseRequest) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{15} }
+
+func (m *RenewLeaseRequest) GetToken() string {
+	if m != nil && m.Token != nil {
+		return *m.Token
+	}
+	return ""
+}
+
+func (m *RenewLeaseRequest) GetDurationMicros() uint64 {
+	if m != nil && m.DurationMicros != nil {
+		return *m.DurationMicros
+	}
+	return 0
+}
+
+type ReleaseSandboxRequest struct {
+	Token *string `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	// Clear both sandboxes before making the pair available.
+	Clear            *bool  `protobuf:"varint,2,opt,name=clear" json:"clear,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ReleaseSandboxRequest) Reset()                    { *m = ReleaseSandboxRequest{} }
+func (m *ReleaseSandboxRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReleaseSandboxRequest) ProtoMessage()               {}
+func (*ReleaseSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{16} }
+
+func (m *ReleaseSandboxRequest) GetToken() string {
+	if m != nil && m.Token != nil {
+		return *m.Token
+	}
+	return ""
+}
+
+func (m *ReleaseSandboxRequest) GetClear() bool {
+	if m != nil && m.Clear != nil {
+		return *m.Clear
+	}
+	return false
+}
+
+type SnapshotRequest struct {
+	// File in a sandbox, e.g. %0.C/solution.
+	Source *string `protobuf:"bytes,1,opt,name=source" json:"source,omitempty"`
+	// Path relative to the overlay base.
+	Destination      *string `protobuf:"bytes,2,opt,name=destination" json:"destination,omitempty"`
+	Lease            *string `protobuf:"bytes,3,opt,name=lease" json:"lease,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *SnapshotRequest) Reset()                    { *m = SnapshotRequest{} }
+func (m *SnapshotRequest) String() string            { return proto.CompactTextString(m) }
+func (*SnapshotRequest) ProtoMessage()               {}
+func (*SnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{17} }
+
+func (m *SnapshotRequest) GetSource() string {
+	if m != nil && m.Source != nil {
+		return *m.Source
+	}
+	return ""
+}
+
+func (m *SnapshotRequest) GetDestination() string {
+	if m != nil && m.Destination != nil {
+		return *m.Destination
+	}
+	return ""
+}
+
+func (m *SnapshotRequest) GetLease() string {
+	if m != nil && m.Lease != nil {
+		return *m.Lease
+	}
+	return ""
+}
+
+type IdentifyRequest struct {
+	ContesterId      *string `protobuf:"bytes,1,opt,name=contester_id,json=contesterId" json:"contester_id,omitempty"`
+	MongoHost        *string `protobuf:"bytes,2,opt,name=mongo_host,json=mongoHost" json:"mongo_host,omitempty"`
+	MongoDb          *string `protobuf:"bytes,3,opt,name=mongo_db,json=mongoDb" json:"mongo_db,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *IdentifyRequest) Reset()                    { *m = IdentifyRequest{} }
+func (m *IdentifyRequest) String() string            { return proto.CompactTextString(m) }
+func (*IdentifyRequest) ProtoMessage()               {}
+func (*IdentifyRequest) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{18} }
+
+func (m *IdentifyRequest) GetContesterId() string {
+	if m != nil && m.ContesterId != nil {
+		return *m.ContesterId
+	}
+	return ""
+}
+
+func (m *IdentifyRequest) GetMongoHost() string {
+	if m != nil && m.MongoHost != nil {
+		return *m.MongoHost
+	}
+	return ""
+}
+
+func (m *IdentifyRequest) GetMongoDb() string {
+	if m != nil && m.MongoDb != nil {
+		return *m.MongoDb
+	}
+	return ""
+}
+
+// Set only for sandboxes with size quota.
+type DiskUsage struct {
+	Quota            *uint64 `protobuf:"varint,1,opt,name=quota" json:"quota,omitempty"`
+	Used             *uint64 `protobuf:"varint,2,opt,name=used" json:"used,omitempty"`
 	XXX_unrecognized []byte  `json:"-"`
 }
 
+func (m *DiskUsage) Reset()                    { *m = DiskUsage{} }
+func (m *DiskUsage) String() string            { return proto.CompactTextString(m) }
+func (*DiskUsage) ProtoMessage()               {}
+func (*DiskUsage) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{19} }
+
+func (m *DiskUsage) GetQuota() uint64 {
+	if m != nil && m.Quota != nil {
+		return *m.Quota
+	}
+	return 0
+}
+
+func (m *DiskUsage) GetUsed() uint64 {
+	if m != nil && m.Used != nil {
+		return *m.Used
+	}
+	return 0
+}
+
+type SandboxLocations struct {
+	Compile          *string    `protobuf:"bytes,1,opt,name=compile" json:"compile,omitempty"`
+	Run              *string    `protobuf:"bytes,2,opt,name=run" json:"run,omitempty"`
+	CompileDisk      *DiskUsage `protobuf:"bytes,3,opt,name=compile_disk,json=compileDisk" json:"compile_disk,omitempty"`
+	RunDisk          *DiskUsage `protobuf:"bytes,4,opt,name=run_disk,json=runDisk" json:"run_disk,omitempty"`
+	XXX_unrecognized []byte     `json:"-"`
+}
+
 func (m *SandboxLocations) Reset()                    { *m = SandboxLocations{} }
 func (m *SandboxLocations) String() string            { return proto.CompactTextString(m) }
 func (*SandboxLocations) ProtoMessage()               {}
-func (*SandboxLocations) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{10} }
+func (*SandboxLocations) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{20} }
 
 func (m *SandboxLocations) GetCompile() string {
 	if m != nil && m.Compile != nil {
@@ -546,21 +1193,41 @@ func (m *SandboxLocations) GetRun() string {
 	return ""
 }
 
+func (m *SandboxLocations) GetCompileDisk() *DiskUsage {
+	if m != nil {
+		return m.CompileDisk
+	}
+	return nil
+}
+
+func (m *SandboxLocations) GetRunDisk() *DiskUsage {
+	if m != nil {
+		return m.RunDisk
+	}
+	return nil
+}
+
 type IdentifyResponse struct {
-	InvokerId        *string             `protobuf:"bytes,1,opt,name=invoker_id,json=invokerId" json:"invoker_id,omitempty"`
-	Sandboxes        []*SandboxLocations `protobuf:"bytes,2,rep,name=sandboxes" json:"sandboxes,omitempty"`
-	Environment      *LocalEnvironment   `protobuf:"bytes,3,opt,name=environment" json:"environment,omitempty"`
-	Platform         *string             `protobuf:"bytes,4,opt,name=platform" json:"platform,omitempty"`
-	PathSeparator    *string             `protobuf:"bytes,5,opt,name=path_separator,json=pathSeparator" json:"path_separator,omitempty"`
-	Disks            []string            `protobuf:"bytes,6,rep,name=disks" json:"disks,omitempty"`
-	ProgramFiles     []string            `protobuf:"bytes,7,rep,name=programFiles" json:"programFiles,omitempty"`
-	XXX_unrecognized []byte              `json:"-"`
+	InvokerId     *string             `protobuf:"bytes,1,opt,name=invoker_id,json=invokerId" json:"invoker_id,omitempty"`
+	Sandboxes     []*SandboxLocations `protobuf:"bytes,2,rep,name=sandboxes" json:"sandboxes,omitempty"`
+	Environment   *LocalEnvironment   `protobuf:"bytes,3,opt,name=environment" json:"environment,omitempty"`
+	Platform      *string             `protobuf:"bytes,4,opt,name=platform" json:"platform,omitempty"`
+	PathSeparator *string             `protobuf:"bytes,5,opt,name=path_separator,json=pathSeparator" json:"path_separator,omitempty"`
+	Disks         []string            `protobuf:"bytes,6,rep,name=disks" json:"disks,omitempty"`
+	ProgramFiles  []string            `protobuf:"bytes,7,rep,name=programFiles" json:"programFiles,omitempty"`
+	// Speed of this host relative to the reference one (bigger is faster), if calibrated.
+	SpeedFactor *float64 `protobuf:"fixed64,8,opt,name=speed_factor,json=speedFactor" json:"speed_factor,omitempty"`
+	// Set if storage downloads are cached locally.
+	DownloadCache *DownloadCacheStats `protobuf:"bytes,9,opt,name=download_cache,json=downloadCache" json:"download_cache,omitempty"`
+	// Set if the last calibration failed, speed_factor is then left from the one before.
+	CalibrationError *string `protobuf:"bytes,10,opt,name=calibration_error,json=calibrationError" json:"calibration_error,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *IdentifyResponse) Reset()                    { *m = IdentifyResponse{} }
 func (m *IdentifyResponse) String() string            { return proto.CompactTextString(m) }
 func (*IdentifyResponse) ProtoMessage()               {}
-func (*IdentifyResponse) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{11} }
+func (*IdentifyResponse) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{21} }
 
 func (m *IdentifyResponse) GetInvokerId() string {
 	if m != nil && m.InvokerId != nil {
@@ -611,18 +1278,131 @@ func (m *IdentifyResponse) GetProgramFiles() []string {
 	return nil
 }
 
+func (m *IdentifyResponse) GetSpeedFactor() float64 {
+	if m != nil && m.SpeedFactor != nil {
+		return *m.SpeedFactor
+	}
+	return 0
+}
+
+func (m *IdentifyResponse) GetDownloadCache() *DownloadCacheStats {
+	if m != nil {
+		return m.DownloadCache
+	}
+	return nil
+}
+
+func (m *IdentifyResponse) GetCalibrationError() string {
+	if m != nil && m.CalibrationError != nil {
+		return *m.CalibrationError
+	}
+	return ""
+}
+
+type DownloadCacheStats struct {
+	Hits             *uint64 `protobuf:"varint,1,opt,name=hits" json:"hits,omitempty"`
+	Misses           *uint64 `protobuf:"varint,2,opt,name=misses" json:"misses,omitempty"`
+	Evictions        *uint64 `protobuf:"varint,3,opt,name=evictions" json:"evictions,omitempty"`
+	Entries          *uint64 `protobuf:"varint,4,opt,name=entries" json:"entries,omitempty"`
+	UsedBytes        *uint64 `protobuf:"varint,5,opt,name=used_bytes,json=usedBytes" json:"used_bytes,omitempty"`
+	MaxBytes         *uint64 `protobuf:"varint,6,opt,name=max_bytes,json=maxBytes" json:"max_bytes,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *DownloadCacheStats) Reset()                    { *m = DownloadCacheStats{} }
+func (m *DownloadCacheStats) String() string            { return proto.CompactTextString(m) }
+func (*DownloadCacheStats) ProtoMessage()               {}
+func (*DownloadCacheStats) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{22} }
+
+func (m *DownloadCacheStats) GetHits() uint64 {
+	if m != nil && m.Hits != nil {
+		return *m.Hits
+	}
+	return 0
+}
+
+func (m *DownloadCacheStats) GetMisses() uint64 {
+	if m != nil && m.Misses != nil {
+		return *m.Misses
+	}
+	return 0
+}
+
+func (m *DownloadCacheStats) GetEvictions() uint64 {
+	if m != nil && m.Evictions != nil {
+		return *m.Evictions
+	}
+	return 0
+}
+
+func (m *DownloadCacheStats) GetEntries() uint64 {
+	if m != nil && m.Entries != nil {
+		return *m.Entries
+	}
+	return 0
+}
+
+func (m *DownloadCacheStats) GetUsedBytes() uint64 {
+	if m != nil && m.UsedBytes != nil {
+		return *m.UsedBytes
+	}
+	return 0
+}
+
+func (m *DownloadCacheStats) GetMaxBytes() uint64 {
+	if m != nil && m.MaxBytes != nil {
+		return *m.MaxBytes
+	}
+	return 0
+}
+
+type CalibrateRequest struct {
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *CalibrateRequest) Reset()                    { *m = CalibrateRequest{} }
+func (m *CalibrateRequest) String() string            { return proto.CompactTextString(m) }
+func (*CalibrateRequest) ProtoMessage()               {}
+func (*CalibrateRequest) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{23} }
+
+type CalibrateResponse struct {
+	SpeedFactor      *float64 `protobuf:"fixed64,1,opt,name=speed_factor,json=speedFactor" json:"speed_factor,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *CalibrateResponse) Reset()                    { *m = CalibrateResponse{} }
+func (m *CalibrateResponse) String() string            { return proto.CompactTextString(m) }
+func (*CalibrateResponse) ProtoMessage()               {}
+func (*CalibrateResponse) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{24} }
+
+func (m *CalibrateResponse) GetSpeedFactor() float64 {
+	if m != nil && m.SpeedFactor != nil {
+		return *m.SpeedFactor
+	}
+	return 0
+}
+
 type FileStat struct {
-	Name             *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	IsDirectory      *bool   `protobuf:"varint,2,opt,name=is_directory,json=isDirectory" json:"is_directory,omitempty"`
-	Size_            *uint64 `protobuf:"varint,3,opt,name=size" json:"size,omitempty"`
-	Checksum         *string `protobuf:"bytes,4,opt,name=checksum" json:"checksum,omitempty"`
+	Name        *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	IsDirectory *bool   `protobuf:"varint,2,opt,name=is_directory,json=isDirectory" json:"is_directory,omitempty"`
+	Size_       *uint64 `protobuf:"varint,3,opt,name=size" json:"size,omitempty"`
+	Checksum    *string `protobuf:"bytes,4,opt,name=checksum" json:"checksum,omitempty"`
+	// Permission bits.
+	Mode *uint32 `protobuf:"varint,5,opt,name=mode" json:"mode,omitempty"`
+	// Modification time, microseconds since the epoch.
+	MtimeMicros *int64 `protobuf:"varint,6,opt,name=mtime_micros,json=mtimeMicros" json:"mtime_micros,omitempty"`
+	// Owner, not set on Windows.
+	Uid *uint32 `protobuf:"varint,7,opt,name=uid" json:"uid,omitempty"`
+	Gid *uint32 `protobuf:"varint,8,opt,name=gid" json:"gid,omitempty"`
+	// Set for symlinks, which are reported as is and never followed.
+	SymlinkTarget    *string `protobuf:"bytes,9,opt,name=symlink_target,json=symlinkTarget" json:"symlink_target,omitempty"`
 	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *FileStat) Reset()                    { *m = FileStat{} }
 func (m *FileStat) String() string            { return proto.CompactTextString(m) }
 func (*FileStat) ProtoMessage()               {}
-func (*FileStat) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{12} }
+func (*FileStat) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{25} }
 
 func (m *FileStat) GetName() string {
 	if m != nil && m.Name != nil {
@@ -652,18 +1432,62 @@ func (m *FileStat) GetChecksum() string {
 	return ""
 }
 
+func (m *FileStat) GetMode() uint32 {
+	if m != nil && m.Mode != nil {
+		return *m.Mode
+	}
+	return 0
+}
+
+func (m *FileStat) GetMtimeMicros() int64 {
+	if m != nil && m.MtimeMicros != nil {
+		return *m.MtimeMicros
+	}
+	return 0
+}
+
+func (m *FileStat) GetUid() uint32 {
+	if m != nil && m.Uid != nil {
+		return *m.Uid
+	}
+	return 0
+}
+
+func (m *FileStat) GetGid() uint32 {
+	if m != nil && m.Gid != nil {
+		return *m.Gid
+	}
+	return 0
+}
+
+func (m *FileStat) GetSymlinkTarget() string {
+	if m != nil && m.SymlinkTarget != nil {
+		return *m.SymlinkTarget
+	}
+	return ""
+}
+
 type StatRequest struct {
 	Name              []string `protobuf:"bytes,1,rep,name=name" json:"name,omitempty"`
 	SandboxId         *string  `protobuf:"bytes,2,opt,name=sandbox_id,json=sandboxId" json:"sandbox_id,omitempty"`
 	Expand            *bool    `protobuf:"varint,3,opt,name=expand" json:"expand,omitempty"`
 	CalculateChecksum *bool    `protobuf:"varint,4,opt,name=calculate_checksum,json=calculateChecksum" json:"calculate_checksum,omitempty"`
-	XXX_unrecognized  []byte   `json:"-"`
+	Lease             *string  `protobuf:"bytes,5,opt,name=lease" json:"lease,omitempty"`
+	// List contents of the directories found, recursively. With expand, ** in the
+	// name matches any number of directories.
+	Recursive *bool `protobuf:"varint,6,opt,name=recursive" json:"recursive,omitempty"`
+	// Limits for recursive listing and ** matching, capped at 32 levels and 10000 entries.
+	MaxDepth   *uint32 `protobuf:"varint,7,opt,name=max_depth,json=maxDepth" json:"max_depth,omitempty"`
+	MaxEntries *uint32 `protobuf:"varint,8,opt,name=max_entries,json=maxEntries" json:"max_entries,omitempty"`
+	// Algorithm for calculate_checksum: sha1 (default), sha256 or blake3.
+	ChecksumAlgorithm *string `protobuf:"bytes,9,opt,name=checksum_algorithm,json=checksumAlgorithm" json:"checksum_algorithm,omitempty"`
+	XXX_unrecognized  []byte  `json:"-"`
 }
 
 func (m *StatRequest) Reset()                    { *m = StatRequest{} }
 func (m *StatRequest) String() string            { return proto.CompactTextString(m) }
 func (*StatRequest) ProtoMessage()               {}
-func (*StatRequest) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{13} }
+func (*StatRequest) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{26} }
 
 func (m *StatRequest) GetName() []string {
 	if m != nil {
@@ -693,15 +1517,55 @@ func (m *StatRequest) GetCalculateChecksum() bool {
 	return false
 }
 
+func (m *StatRequest) GetLease() string {
+	if m != nil && m.Lease != nil {
+		return *m.Lease
+	}
+	return ""
+}
+
+func (m *StatRequest) GetRecursive() bool {
+	if m != nil && m.Recursive != nil {
+		return *m.Recursive
+	}
+	return false
+}
+
+func (m *StatRequest) GetMaxDepth() uint32 {
+	if m != nil && m.MaxDepth != nil {
+		return *m.MaxDepth
+	}
+	return 0
+}
+
+func (m *StatRequest) GetMaxEntries() uint32 {
+	if m != nil && m.MaxEntries != nil {
+		return *m.MaxEntries
+	}
+	return 0
+}
+
+func (m *StatRequest) GetChecksumAlgorithm() string {
+	if m != nil && m.ChecksumAlgorithm != nil {
+		return *m.ChecksumAlgorithm
+	}
+	return ""
+}
+
 type FileStats struct {
-	Entries          []*FileStat `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
-	XXX_unrecognized []byte      `json:"-"`
+	Entries []*FileStat `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+	// Set if max_entries was hit and the listing is incomplete.
+	Truncated *bool `protobuf:"varint,2,opt,name=truncated" json:"truncated,omitempty"`
+	// GridfsCopy only: one per request entry, in the same order. Entries has stats of the
+	// successful ones.
+	CopyResults      []*CopyResult `protobuf:"bytes,3,rep,name=copy_results,json=copyResults" json:"copy_results,omitempty"`
+	XXX_unrecognized []byte        `json:"-"`
 }
 
 func (m *FileStats) Reset()                    { *m = FileStats{} }
 func (m *FileStats) String() string            { return proto.CompactTextString(m) }
 func (*FileStats) ProtoMessage()               {}
-func (*FileStats) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{14} }
+func (*FileStats) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{27} }
 
 func (m *FileStats) GetEntries() []*FileStat {
 	if m != nil {
@@ -710,15 +1574,32 @@ func (m *FileStats) GetEntries() []*FileStat {
 	return nil
 }
 
+func (m *FileStats) GetTruncated() bool {
+	if m != nil && m.Truncated != nil {
+		return *m.Truncated
+	}
+	return false
+}
+
+func (m *FileStats) GetCopyResults() []*CopyResult {
+	if m != nil {
+		return m.CopyResults
+	}
+	return nil
+}
+
 type GetRequest struct {
-	Name             *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	XXX_unrecognized []byte  `json:"-"`
+	Name  *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Lease *string `protobuf:"bytes,2,opt,name=lease" json:"lease,omitempty"`
+	// Algorithm for the blob digest, sha1 by default.
+	ChecksumAlgorithm *string `protobuf:"bytes,3,opt,name=checksum_algorithm,json=checksumAlgorithm" json:"checksum_algorithm,omitempty"`
+	XXX_unrecognized  []byte  `json:"-"`
 }
 
 func (m *GetRequest) Reset()                    { *m = GetRequest{} }
 func (m *GetRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()               {}
-func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{15} }
+func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{28} }
 
 func (m *GetRequest) GetName() string {
 	if m != nil && m.Name != nil {
@@ -727,49 +1608,342 @@ func (m *GetRequest) GetName() string {
 	return ""
 }
 
-type EmptyMessage struct {
-	XXX_unrecognized []byte `json:"-"`
+func (m *GetRequest) GetLease() string {
+	if m != nil && m.Lease != nil {
+		return *m.Lease
+	}
+	return ""
 }
 
-func (m *EmptyMessage) Reset()                    { *m = EmptyMessage{} }
-func (m *EmptyMessage) String() string            { return proto.CompactTextString(m) }
-func (*EmptyMessage) ProtoMessage()               {}
-func (*EmptyMessage) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{16} }
+func (m *GetRequest) GetChecksumAlgorithm() string {
+	if m != nil && m.ChecksumAlgorithm != nil {
+		return *m.ChecksumAlgorithm
+	}
+	return ""
+}
 
-type CopyOperation struct {
-	LocalFileName      *string `protobuf:"bytes,1,opt,name=local_file_name,json=localFileName" json:"local_file_name,omitempty"`
-	RemoteLocation     *string `protobuf:"bytes,2,opt,name=remote_location,json=remoteLocation" json:"remote_location,omitempty"`
-	Upload             *bool   `protobuf:"varint,3,opt,name=upload" json:"upload,omitempty"`
-	Checksum           *string `protobuf:"bytes,4,opt,name=checksum" json:"checksum,omitempty"`
-	ModuleType         *string `protobuf:"bytes,5,opt,name=module_type,json=moduleType" json:"module_type,omitempty"`
-	AuthorizationToken *string `protobuf:"bytes,6,opt,name=authorization_token,json=authorizationToken" json:"authorization_token,omitempty"`
-	XXX_unrecognized   []byte  `json:"-"`
+// Chunks of one upload must come in order, starting from offset 0. Data SHA1 covers the chunk.
+type PutChunkRequest struct {
+	Name   *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Offset *uint64 `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
+	Data   *Blob   `protobuf:"bytes,3,opt,name=data" json:"data,omitempty"`
+	// Last chunk: whole file is checked against sha1 or checksum, and FileStat is returned.
+	Final *bool   `protobuf:"varint,4,opt,name=final" json:"final,omitempty"`
+	Sha1  []byte  `protobuf:"bytes,5,opt,name=sha1" json:"sha1,omitempty"`
+	Lease *string `protobuf:"bytes,6,opt,name=lease" json:"lease,omitempty"`
+	// Whole file checksum as algo:hex, takes precedence over sha1.
+	Checksum         *string `protobuf:"bytes,7,opt,name=checksum" json:"checksum,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
 }
 
-func (m *CopyOperation) Reset()                    { *m = CopyOperation{} }
-func (m *CopyOperation) String() string            { return proto.CompactTextString(m) }
-func (*CopyOperation) ProtoMessage()               {}
-func (*CopyOperation) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{17} }
+func (m *PutChunkRequest) Reset()                    { *m = PutChunkRequest{} }
+func (m *PutChunkRequest) String() string            { return proto.CompactTextString(m) }
+func (*PutChunkRequest) ProtoMessage()               {}
+func (*PutChunkRequest) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{29} }
 
-func (m *CopyOperation) GetLocalFileName() string {
-	if m != nil && m.LocalFileName != nil {
-		return *m.LocalFileName
+func (m *PutChunkRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
 	return ""
 }
 
-func (m *CopyOperation) GetRemoteLocation() string {
-	if m != nil && m.RemoteLocation != nil {
-		return *m.RemoteLocation
+func (m *PutChunkRequest) GetOffset() uint64 {
+	if m != nil && m.Offset != nil {
+		return *m.Offset
 	}
-	return ""
+	return 0
 }
 
-func (m *CopyOperation) GetUpload() bool {
-	if m != nil && m.Upload != nil {
-		return *m.Upload
+func (m *PutChunkRequest) GetData() *Blob {
+	if m != nil {
+		return m.Data
 	}
-	return false
+	return nil
+}
+
+func (m *PutChunkRequest) GetFinal() bool {
+	if m != nil && m.Final != nil {
+		return *m.Final
+	}
+	return false
+}
+
+func (m *PutChunkRequest) GetSha1() []byte {
+	if m != nil {
+		return m.Sha1
+	}
+	return nil
+}
+
+func (m *PutChunkRequest) GetLease() string {
+	if m != nil && m.Lease != nil {
+		return *m.Lease
+	}
+	return ""
+}
+
+func (m *PutChunkRequest) GetChecksum() string {
+	if m != nil && m.Checksum != nil {
+		return *m.Checksum
+	}
+	return ""
+}
+
+type GetChunkRequest struct {
+	Name   *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Offset *uint64 `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
+	// At most 4MB, which is also the default.
+	Length           *uint64 `protobuf:"varint,3,opt,name=length" json:"length,omitempty"`
+	Lease            *string `protobuf:"bytes,4,opt,name=lease" json:"lease,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *GetChunkRequest) Reset()                    { *m = GetChunkRequest{} }
+func (m *GetChunkRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetChunkRequest) ProtoMessage()               {}
+func (*GetChunkRequest) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{30} }
+
+func (m *GetChunkRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *GetChunkRequest) GetOffset() uint64 {
+	if m != nil && m.Offset != nil {
+		return *m.Offset
+	}
+	return 0
+}
+
+func (m *GetChunkRequest) GetLength() uint64 {
+	if m != nil && m.Length != nil {
+		return *m.Length
+	}
+	return 0
+}
+
+func (m *GetChunkRequest) GetLease() string {
+	if m != nil && m.Lease != nil {
+		return *m.Lease
+	}
+	return ""
+}
+
+type FileChunk struct {
+	Offset   *uint64 `protobuf:"varint,1,opt,name=offset" json:"offset,omitempty"`
+	Data     *Blob   `protobuf:"bytes,2,opt,name=data" json:"data,omitempty"`
+	FileSize *uint64 `protobuf:"varint,3,opt,name=file_size,json=fileSize" json:"file_size,omitempty"`
+	// Chunk reaches the end of the file. sha1 is the whole file one, set if the file was
+	// read in order from the start.
+	Eof              *bool  `protobuf:"varint,4,opt,name=eof" json:"eof,omitempty"`
+	Sha1             []byte `protobuf:"bytes,5,opt,name=sha1" json:"sha1,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *FileChunk) Reset()                    { *m = FileChunk{} }
+func (m *FileChunk) String() string            { return proto.CompactTextString(m) }
+func (*FileChunk) ProtoMessage()               {}
+func (*FileChunk) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{31} }
+
+func (m *FileChunk) GetOffset() uint64 {
+	if m != nil && m.Offset != nil {
+		return *m.Offset
+	}
+	return 0
+}
+
+func (m *FileChunk) GetData() *Blob {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *FileChunk) GetFileSize() uint64 {
+	if m != nil && m.FileSize != nil {
+		return *m.FileSize
+	}
+	return 0
+}
+
+func (m *FileChunk) GetEof() bool {
+	if m != nil && m.Eof != nil {
+		return *m.Eof
+	}
+	return false
+}
+
+func (m *FileChunk) GetSha1() []byte {
+	if m != nil {
+		return m.Sha1
+	}
+	return nil
+}
+
+type PutArchiveRequest struct {
+	// Directory to extract into, must be in a sandbox. Created if missing.
+	Destination      *string        `protobuf:"bytes,1,req,name=destination" json:"destination,omitempty"`
+	Data             *Blob          `protobuf:"bytes,2,opt,name=data" json:"data,omitempty"`
+	Format           *ArchiveFormat `protobuf:"varint,3,opt,name=format,enum=contester.proto.ArchiveFormat" json:"format,omitempty"`
+	Lease            *string        `protobuf:"bytes,4,opt,name=lease" json:"lease,omitempty"`
+	XXX_unrecognized []byte         `json:"-"`
+}
+
+func (m *PutArchiveRequest) Reset()                    { *m = PutArchiveRequest{} }
+func (m *PutArchiveRequest) String() string            { return proto.CompactTextString(m) }
+func (*PutArchiveRequest) ProtoMessage()               {}
+func (*PutArchiveRequest) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{32} }
+
+func (m *PutArchiveRequest) GetDestination() string {
+	if m != nil && m.Destination != nil {
+		return *m.Destination
+	}
+	return ""
+}
+
+func (m *PutArchiveRequest) GetData() *Blob {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *PutArchiveRequest) GetFormat() ArchiveFormat {
+	if m != nil && m.Format != nil {
+		return *m.Format
+	}
+	return ArchiveFormat_TAR
+}
+
+func (m *PutArchiveRequest) GetLease() string {
+	if m != nil && m.Lease != nil {
+		return *m.Lease
+	}
+	return ""
+}
+
+type GetArchiveRequest struct {
+	// Directory to pack, or glob pattern if expand is set. Names in the archive are
+	// relative to the directory, or to the one containing the pattern.
+	Name             *string        `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Expand           *bool          `protobuf:"varint,2,opt,name=expand" json:"expand,omitempty"`
+	Format           *ArchiveFormat `protobuf:"varint,3,opt,name=format,enum=contester.proto.ArchiveFormat" json:"format,omitempty"`
+	Lease            *string        `protobuf:"bytes,4,opt,name=lease" json:"lease,omitempty"`
+	XXX_unrecognized []byte         `json:"-"`
+}
+
+func (m *GetArchiveRequest) Reset()                    { *m = GetArchiveRequest{} }
+func (m *GetArchiveRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetArchiveRequest) ProtoMessage()               {}
+func (*GetArchiveRequest) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{33} }
+
+func (m *GetArchiveRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *GetArchiveRequest) GetExpand() bool {
+	if m != nil && m.Expand != nil {
+		return *m.Expand
+	}
+	return false
+}
+
+func (m *GetArchiveRequest) GetFormat() ArchiveFormat {
+	if m != nil && m.Format != nil {
+		return *m.Format
+	}
+	return ArchiveFormat_TAR
+}
+
+func (m *GetArchiveRequest) GetLease() string {
+	if m != nil && m.Lease != nil {
+		return *m.Lease
+	}
+	return ""
+}
+
+type Archive struct {
+	Data             *Blob          `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Format           *ArchiveFormat `protobuf:"varint,2,opt,name=format,enum=contester.proto.ArchiveFormat" json:"format,omitempty"`
+	Files            *uint32        `protobuf:"varint,3,opt,name=files" json:"files,omitempty"`
+	XXX_unrecognized []byte         `json:"-"`
+}
+
+func (m *Archive) Reset()                    { *m = Archive{} }
+func (m *Archive) String() string            { return proto.CompactTextString(m) }
+func (*Archive) ProtoMessage()               {}
+func (*Archive) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{34} }
+
+func (m *Archive) GetData() *Blob {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Archive) GetFormat() ArchiveFormat {
+	if m != nil && m.Format != nil {
+		return *m.Format
+	}
+	return ArchiveFormat_TAR
+}
+
+func (m *Archive) GetFiles() uint32 {
+	if m != nil && m.Files != nil {
+		return *m.Files
+	}
+	return 0
+}
+
+type EmptyMessage struct {
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *EmptyMessage) Reset()                    { *m = EmptyMessage{} }
+func (m *EmptyMessage) String() string            { return proto.CompactTextString(m) }
+func (*EmptyMessage) ProtoMessage()               {}
+func (*EmptyMessage) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{35} }
+
+type CopyOperation struct {
+	LocalFileName      *string `protobuf:"bytes,1,opt,name=local_file_name,json=localFileName" json:"local_file_name,omitempty"`
+	RemoteLocation     *string `protobuf:"bytes,2,opt,name=remote_location,json=remoteLocation" json:"remote_location,omitempty"`
+	Upload             *bool   `protobuf:"varint,3,opt,name=upload" json:"upload,omitempty"`
+	Checksum           *string `protobuf:"bytes,4,opt,name=checksum" json:"checksum,omitempty"`
+	ModuleType         *string `protobuf:"bytes,5,opt,name=module_type,json=moduleType" json:"module_type,omitempty"`
+	AuthorizationToken *string `protobuf:"bytes,6,opt,name=authorization_token,json=authorizationToken" json:"authorization_token,omitempty"`
+	XXX_unrecognized   []byte  `json:"-"`
+}
+
+func (m *CopyOperation) Reset()                    { *m = CopyOperation{} }
+func (m *CopyOperation) String() string            { return proto.CompactTextString(m) }
+func (*CopyOperation) ProtoMessage()               {}
+func (*CopyOperation) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{36} }
+
+func (m *CopyOperation) GetLocalFileName() string {
+	if m != nil && m.LocalFileName != nil {
+		return *m.LocalFileName
+	}
+	return ""
+}
+
+func (m *CopyOperation) GetRemoteLocation() string {
+	if m != nil && m.RemoteLocation != nil {
+		return *m.RemoteLocation
+	}
+	return ""
+}
+
+func (m *CopyOperation) GetUpload() bool {
+	if m != nil && m.Upload != nil {
+		return *m.Upload
+	}
+	return false
 }
 
 func (m *CopyOperation) GetChecksum() string {
@@ -794,15 +1968,20 @@ func (m *CopyOperation) GetAuthorizationToken() string {
 }
 
 type CopyOperations struct {
-	Entries          []*CopyOperation `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
-	SandboxId        *string          `protobuf:"bytes,2,opt,name=sandbox_id,json=sandboxId" json:"sandbox_id,omitempty"`
-	XXX_unrecognized []byte           `json:"-"`
+	Entries   []*CopyOperation `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+	SandboxId *string          `protobuf:"bytes,2,opt,name=sandbox_id,json=sandboxId" json:"sandbox_id,omitempty"`
+	Lease     *string          `protobuf:"bytes,3,opt,name=lease" json:"lease,omitempty"`
+	// Transfers running at once, 4 by default and at most 16.
+	Parallelism *uint32 `protobuf:"varint,4,opt,name=parallelism" json:"parallelism,omitempty"`
+	// Skip the entries not started yet once one of them fails.
+	FailFast         *bool  `protobuf:"varint,5,opt,name=fail_fast,json=failFast" json:"fail_fast,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *CopyOperations) Reset()                    { *m = CopyOperations{} }
 func (m *CopyOperations) String() string            { return proto.CompactTextString(m) }
 func (*CopyOperations) ProtoMessage()               {}
-func (*CopyOperations) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{18} }
+func (*CopyOperations) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{37} }
 
 func (m *CopyOperations) GetEntries() []*CopyOperation {
 	if m != nil {
@@ -818,6 +1997,68 @@ func (m *CopyOperations) GetSandboxId() string {
 	return ""
 }
 
+func (m *CopyOperations) GetLease() string {
+	if m != nil && m.Lease != nil {
+		return *m.Lease
+	}
+	return ""
+}
+
+func (m *CopyOperations) GetParallelism() uint32 {
+	if m != nil && m.Parallelism != nil {
+		return *m.Parallelism
+	}
+	return 0
+}
+
+func (m *CopyOperations) GetFailFast() bool {
+	if m != nil && m.FailFast != nil {
+		return *m.FailFast
+	}
+	return false
+}
+
+type CopyResult struct {
+	Status           *CopyResult_Status `protobuf:"varint,1,opt,name=status,enum=contester.proto.CopyResult_Status" json:"status,omitempty"`
+	Error            *string            `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
+	Bytes            *uint64            `protobuf:"varint,3,opt,name=bytes" json:"bytes,omitempty"`
+	DurationMicros   *uint64            `protobuf:"varint,4,opt,name=duration_micros,json=durationMicros" json:"duration_micros,omitempty"`
+	XXX_unrecognized []byte             `json:"-"`
+}
+
+func (m *CopyResult) Reset()                    { *m = CopyResult{} }
+func (m *CopyResult) String() string            { return proto.CompactTextString(m) }
+func (*CopyResult) ProtoMessage()               {}
+func (*CopyResult) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{38} }
+
+func (m *CopyResult) GetStatus() CopyResult_Status {
+	if m != nil && m.Status != nil {
+		return *m.Status
+	}
+	return CopyResult_OK
+}
+
+func (m *CopyResult) GetError() string {
+	if m != nil && m.Error != nil {
+		return *m.Error
+	}
+	return ""
+}
+
+func (m *CopyResult) GetBytes() uint64 {
+	if m != nil && m.Bytes != nil {
+		return *m.Bytes
+	}
+	return 0
+}
+
+func (m *CopyResult) GetDurationMicros() uint64 {
+	if m != nil && m.DurationMicros != nil {
+		return *m.DurationMicros
+	}
+	return 0
+}
+
 type NamePair struct {
 	Source           *string `protobuf:"bytes,1,req,name=source" json:"source,omitempty"`
 	Destination      *string `protobuf:"bytes,2,req,name=destination" json:"destination,omitempty"`
@@ -827,7 +2068,7 @@ type NamePair struct {
 func (m *NamePair) Reset()                    { *m = NamePair{} }
 func (m *NamePair) String() string            { return proto.CompactTextString(m) }
 func (*NamePair) ProtoMessage()               {}
-func (*NamePair) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{19} }
+func (*NamePair) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{39} }
 
 func (m *NamePair) GetSource() string {
 	if m != nil && m.Source != nil {
@@ -843,16 +2084,18 @@ func (m *NamePair) GetDestination() string {
 	return ""
 }
 
+// Copy and Move: destinations must be in sandboxes, Move sources too.
 type RepeatedNamePairEntries struct {
 	Entries          []*NamePair `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
 	SandboxId        *string     `protobuf:"bytes,2,opt,name=sandbox_id,json=sandboxId" json:"sandbox_id,omitempty"`
+	Lease            *string     `protobuf:"bytes,3,opt,name=lease" json:"lease,omitempty"`
 	XXX_unrecognized []byte      `json:"-"`
 }
 
 func (m *RepeatedNamePairEntries) Reset()                    { *m = RepeatedNamePairEntries{} }
 func (m *RepeatedNamePairEntries) String() string            { return proto.CompactTextString(m) }
 func (*RepeatedNamePairEntries) ProtoMessage()               {}
-func (*RepeatedNamePairEntries) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{20} }
+func (*RepeatedNamePairEntries) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{40} }
 
 func (m *RepeatedNamePairEntries) GetEntries() []*NamePair {
 	if m != nil {
@@ -868,15 +2111,24 @@ func (m *RepeatedNamePairEntries) GetSandboxId() string {
 	return ""
 }
 
+func (m *RepeatedNamePairEntries) GetLease() string {
+	if m != nil && m.Lease != nil {
+		return *m.Lease
+	}
+	return ""
+}
+
+// Remove and Mkdir, paths must be in sandboxes.
 type RepeatedStringEntries struct {
 	Entries          []string `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+	Lease            *string  `protobuf:"bytes,2,opt,name=lease" json:"lease,omitempty"`
 	XXX_unrecognized []byte   `json:"-"`
 }
 
 func (m *RepeatedStringEntries) Reset()                    { *m = RepeatedStringEntries{} }
 func (m *RepeatedStringEntries) String() string            { return proto.CompactTextString(m) }
 func (*RepeatedStringEntries) ProtoMessage()               {}
-func (*RepeatedStringEntries) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{21} }
+func (*RepeatedStringEntries) Descriptor() ([]byte, []int) { return fileDescriptorLocal, []int{41} }
 
 func (m *RepeatedStringEntries) GetEntries() []string {
 	if m != nil {
@@ -885,31 +2137,63 @@ func (m *RepeatedStringEntries) GetEntries() []string {
 	return nil
 }
 
+func (m *RepeatedStringEntries) GetLease() string {
+	if m != nil && m.Lease != nil {
+		return *m.Lease
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*LocalEnvironment)(nil), "contester.proto.LocalEnvironment")
 	proto.RegisterType((*LocalEnvironment_Variable)(nil), "contester.proto.LocalEnvironment.Variable")
 	proto.RegisterType((*LocalExecutionParameters)(nil), "contester.proto.LocalExecutionParameters")
 	proto.RegisterType((*LocalExecuteConnected)(nil), "contester.proto.LocalExecuteConnected")
 	proto.RegisterType((*LocalExecutionResult)(nil), "contester.proto.LocalExecutionResult")
+	proto.RegisterType((*SetupFailure)(nil), "contester.proto.SetupFailure")
+	proto.RegisterType((*RepeatStatistics)(nil), "contester.proto.RepeatStatistics")
+	proto.RegisterType((*RepeatStatistics_Range)(nil), "contester.proto.RepeatStatistics.Range")
 	proto.RegisterType((*LocalExecuteConnectedResult)(nil), "contester.proto.LocalExecuteConnectedResult")
+	proto.RegisterType((*LocalExecuteGraph)(nil), "contester.proto.LocalExecuteGraph")
+	proto.RegisterType((*LocalExecuteGraph_Edge)(nil), "contester.proto.LocalExecuteGraph.Edge")
+	proto.RegisterType((*LocalExecuteGraphResult)(nil), "contester.proto.LocalExecuteGraphResult")
 	proto.RegisterType((*LocalExecution)(nil), "contester.proto.LocalExecution")
 	proto.RegisterType((*BinaryTypeRequest)(nil), "contester.proto.BinaryTypeRequest")
 	proto.RegisterType((*BinaryTypeResponse)(nil), "contester.proto.BinaryTypeResponse")
 	proto.RegisterType((*ClearSandboxRequest)(nil), "contester.proto.ClearSandboxRequest")
+	proto.RegisterType((*AcquireSandboxRequest)(nil), "contester.proto.AcquireSandboxRequest")
+	proto.RegisterType((*SandboxLease)(nil), "contester.proto.SandboxLease")
+	proto.RegisterType((*RenewLeaseRequest)(nil), "contester.proto.RenewLeaseRequest")
+	proto.RegisterType((*ReleaseSandboxRequest)(nil), "contester.proto.ReleaseSandboxRequest")
+	proto.RegisterType((*SnapshotRequest)(nil), "contester.proto.SnapshotRequest")
 	proto.RegisterType((*IdentifyRequest)(nil), "contester.proto.IdentifyRequest")
+	proto.RegisterType((*DiskUsage)(nil), "contester.proto.DiskUsage")
 	proto.RegisterType((*SandboxLocations)(nil), "contester.proto.SandboxLocations")
 	proto.RegisterType((*IdentifyResponse)(nil), "contester.proto.IdentifyResponse")
+	proto.RegisterType((*DownloadCacheStats)(nil), "contester.proto.DownloadCacheStats")
+	proto.RegisterType((*CalibrateRequest)(nil), "contester.proto.CalibrateRequest")
+	proto.RegisterType((*CalibrateResponse)(nil), "contester.proto.CalibrateResponse")
 	proto.RegisterType((*FileStat)(nil), "contester.proto.FileStat")
 	proto.RegisterType((*StatRequest)(nil), "contester.proto.StatRequest")
 	proto.RegisterType((*FileStats)(nil), "contester.proto.FileStats")
 	proto.RegisterType((*GetRequest)(nil), "contester.proto.GetRequest")
+	proto.RegisterType((*PutChunkRequest)(nil), "contester.proto.PutChunkRequest")
+	proto.RegisterType((*GetChunkRequest)(nil), "contester.proto.GetChunkRequest")
+	proto.RegisterType((*FileChunk)(nil), "contester.proto.FileChunk")
+	proto.RegisterType((*PutArchiveRequest)(nil), "contester.proto.PutArchiveRequest")
+	proto.RegisterType((*GetArchiveRequest)(nil), "contester.proto.GetArchiveRequest")
+	proto.RegisterType((*Archive)(nil), "contester.proto.Archive")
 	proto.RegisterType((*EmptyMessage)(nil), "contester.proto.EmptyMessage")
 	proto.RegisterType((*CopyOperation)(nil), "contester.proto.CopyOperation")
 	proto.RegisterType((*CopyOperations)(nil), "contester.proto.CopyOperations")
+	proto.RegisterType((*CopyResult)(nil), "contester.proto.CopyResult")
 	proto.RegisterType((*NamePair)(nil), "contester.proto.NamePair")
 	proto.RegisterType((*RepeatedNamePairEntries)(nil), "contester.proto.RepeatedNamePairEntries")
 	proto.RegisterType((*RepeatedStringEntries)(nil), "contester.proto.RepeatedStringEntries")
+	proto.RegisterEnum("contester.proto.ArchiveFormat", ArchiveFormat_name, ArchiveFormat_value)
+	proto.RegisterEnum("contester.proto.LocalExecutionParameters_RepeatPolicy", LocalExecutionParameters_RepeatPolicy_name, LocalExecutionParameters_RepeatPolicy_value)
 	proto.RegisterEnum("contester.proto.BinaryTypeResponse_Win32BinaryType", BinaryTypeResponse_Win32BinaryType_name, BinaryTypeResponse_Win32BinaryType_value)
+	proto.RegisterEnum("contester.proto.CopyResult_Status", CopyResult_Status_name, CopyResult_Status_value)
 }
 func (m *LocalEnvironment) Marshal() (data []byte, err error) {
 	size := m.Size()
@@ -1159,6 +2443,52 @@ func (m *LocalExecutionParameters) MarshalTo(data []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Repeat != nil {
+		data[i] = 0x98
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Repeat))
+	}
+	if m.RepeatPolicy != nil {
+		data[i] = 0xa0
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.RepeatPolicy))
+	}
+	if m.NormalizeTime != nil {
+		data[i] = 0xa8
+		i++
+		data[i] = 0x1
+		i++
+		if *m.NormalizeTime {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.SearchPath != nil {
+		data[i] = 0xb0
+		i++
+		data[i] = 0x1
+		i++
+		if *m.SearchPath {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Lease != nil {
+		data[i] = 0xba
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Lease)))
+		i += copy(data[i:], *m.Lease)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -1200,6 +2530,16 @@ func (m *LocalExecuteConnected) MarshalTo(data []byte) (int, error) {
 		}
 		i += n6
 	}
+	if m.RecordTranscript != nil {
+		data[i] = 0x18
+		i++
+		if *m.RecordTranscript {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -1286,13 +2626,63 @@ func (m *LocalExecutionResult) MarshalTo(data []byte) (int, error) {
 		i++
 		i = encodeVarintLocal(data, i, uint64(*m.StopSignal))
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(data[i:], m.XXX_unrecognized)
+	if m.StdOutTruncated != nil {
+		data[i] = 0x50
+		i++
+		if *m.StdOutTruncated {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
 	}
-	return i, nil
-}
+	if m.StdErrTruncated != nil {
+		data[i] = 0x58
+		i++
+		if *m.StdErrTruncated {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.RepeatStatistics != nil {
+		data[i] = 0x62
+		i++
+		i = encodeVarintLocal(data, i, uint64(m.RepeatStatistics.Size()))
+		n11, err := m.RepeatStatistics.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.NormalizedTime != nil {
+		data[i] = 0x6a
+		i++
+		i = encodeVarintLocal(data, i, uint64(m.NormalizedTime.Size()))
+		n12, err := m.NormalizedTime.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.SetupFailure != nil {
+		data[i] = 0x72
+		i++
+		i = encodeVarintLocal(data, i, uint64(m.SetupFailure.Size()))
+		n13, err := m.SetupFailure.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
 
-func (m *LocalExecuteConnectedResult) Marshal() (data []byte, err error) {
+func (m *SetupFailure) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1302,30 +2692,43 @@ func (m *LocalExecuteConnectedResult) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *LocalExecuteConnectedResult) MarshalTo(data []byte) (int, error) {
+func (m *SetupFailure) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.First != nil {
+	if m.Stage != nil {
 		data[i] = 0xa
 		i++
-		i = encodeVarintLocal(data, i, uint64(m.First.Size()))
-		n11, err := m.First.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
+		i = encodeVarintLocal(data, i, uint64(len(*m.Stage)))
+		i += copy(data[i:], *m.Stage)
 	}
-	if m.Second != nil {
-		data[i] = 0x12
+	if m.Errno != nil {
+		data[i] = 0x10
 		i++
-		i = encodeVarintLocal(data, i, uint64(m.Second.Size()))
-		n12, err := m.Second.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
+		i = encodeVarintLocal(data, i, uint64(*m.Errno))
+	}
+	if m.Path != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Path)))
+		i += copy(data[i:], *m.Path)
+	}
+	if m.UserError != nil {
+		data[i] = 0x20
+		i++
+		if *m.UserError {
+			data[i] = 1
+		} else {
+			data[i] = 0
 		}
-		i += n12
+		i++
+	}
+	if m.Message != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Message)))
+		i += copy(data[i:], *m.Message)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -1333,7 +2736,7 @@ func (m *LocalExecuteConnectedResult) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
-func (m *LocalExecution) Marshal() (data []byte, err error) {
+func (m *RepeatStatistics) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1343,32 +2746,45 @@ func (m *LocalExecution) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *LocalExecution) MarshalTo(data []byte) (int, error) {
+func (m *RepeatStatistics) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Parameters == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
-	} else {
-		data[i] = 0xa
+	if m.Runs != nil {
+		data[i] = 0x8
 		i++
-		i = encodeVarintLocal(data, i, uint64(m.Parameters.Size()))
-		n13, err := m.Parameters.MarshalTo(data[i:])
+		i = encodeVarintLocal(data, i, uint64(*m.Runs))
+	}
+	if m.UserTimeMicros != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintLocal(data, i, uint64(m.UserTimeMicros.Size()))
+		n14, err := m.UserTimeMicros.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n14
 	}
-	if m.Result != nil {
-		data[i] = 0x12
+	if m.WallTimeMicros != nil {
+		data[i] = 0x1a
 		i++
-		i = encodeVarintLocal(data, i, uint64(m.Result.Size()))
-		n14, err := m.Result.MarshalTo(data[i:])
+		i = encodeVarintLocal(data, i, uint64(m.WallTimeMicros.Size()))
+		n15, err := m.WallTimeMicros.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n15
+	}
+	if m.Memory != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintLocal(data, i, uint64(m.Memory.Size()))
+		n16, err := m.Memory.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -1376,7 +2792,7 @@ func (m *LocalExecution) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
-func (m *BinaryTypeRequest) Marshal() (data []byte, err error) {
+func (m *RepeatStatistics_Range) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1386,16 +2802,25 @@ func (m *BinaryTypeRequest) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *BinaryTypeRequest) MarshalTo(data []byte) (int, error) {
+func (m *RepeatStatistics_Range) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Pathname != nil {
-		data[i] = 0xa
+	if m.Min != nil {
+		data[i] = 0x8
 		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.Pathname)))
-		i += copy(data[i:], *m.Pathname)
+		i = encodeVarintLocal(data, i, uint64(*m.Min))
+	}
+	if m.Median != nil {
+		data[i] = 0x10
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Median))
+	}
+	if m.Max != nil {
+		data[i] = 0x18
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Max))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -1403,7 +2828,7 @@ func (m *BinaryTypeRequest) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
-func (m *BinaryTypeResponse) Marshal() (data []byte, err error) {
+func (m *LocalExecuteConnectedResult) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1413,33 +2838,58 @@ func (m *BinaryTypeResponse) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *BinaryTypeResponse) MarshalTo(data []byte) (int, error) {
+func (m *LocalExecuteConnectedResult) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Failure != nil {
-		data[i] = 0x8
+	if m.First != nil {
+		data[i] = 0xa
 		i++
-		if *m.Failure {
+		i = encodeVarintLocal(data, i, uint64(m.First.Size()))
+		n17, err := m.First.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if m.Second != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintLocal(data, i, uint64(m.Second.Size()))
+		n18, err := m.Second.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if m.Transcript != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintLocal(data, i, uint64(m.Transcript.Size()))
+		n19, err := m.Transcript.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	if m.TranscriptTruncated != nil {
+		data[i] = 0x20
+		i++
+		if *m.TranscriptTruncated {
 			data[i] = 1
 		} else {
 			data[i] = 0
 		}
 		i++
 	}
-	if m.Result != nil {
-		data[i] = 0x10
-		i++
-		i = encodeVarintLocal(data, i, uint64(*m.Result))
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *ClearSandboxRequest) Marshal() (data []byte, err error) {
+func (m *LocalExecuteGraph) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1449,16 +2899,34 @@ func (m *ClearSandboxRequest) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *ClearSandboxRequest) MarshalTo(data []byte) (int, error) {
+func (m *LocalExecuteGraph) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Sandbox != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.Sandbox)))
-		i += copy(data[i:], *m.Sandbox)
+	if len(m.Processes) > 0 {
+		for _, msg := range m.Processes {
+			data[i] = 0xa
+			i++
+			i = encodeVarintLocal(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Edges) > 0 {
+		for _, msg := range m.Edges {
+			data[i] = 0x12
+			i++
+			i = encodeVarintLocal(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -1466,7 +2934,7 @@ func (m *ClearSandboxRequest) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
-func (m *IdentifyRequest) Marshal() (data []byte, err error) {
+func (m *LocalExecuteGraph_Edge) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1476,28 +2944,40 @@ func (m *IdentifyRequest) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *IdentifyRequest) MarshalTo(data []byte) (int, error) {
+func (m *LocalExecuteGraph_Edge) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.ContesterId != nil {
-		data[i] = 0xa
+	if m.Source != nil {
+		data[i] = 0x8
 		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.ContesterId)))
-		i += copy(data[i:], *m.ContesterId)
+		i = encodeVarintLocal(data, i, uint64(*m.Source))
 	}
-	if m.MongoHost != nil {
-		data[i] = 0x12
+	if m.SourceFd != nil {
+		data[i] = 0x10
 		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.MongoHost)))
-		i += copy(data[i:], *m.MongoHost)
+		i = encodeVarintLocal(data, i, uint64(*m.SourceFd))
 	}
-	if m.MongoDb != nil {
-		data[i] = 0x1a
+	if m.Destination != nil {
+		data[i] = 0x18
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Destination))
+	}
+	if m.DestinationFd != nil {
+		data[i] = 0x20
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.DestinationFd))
+	}
+	if m.Record != nil {
+		data[i] = 0x28
+		i++
+		if *m.Record {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
 		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.MongoDb)))
-		i += copy(data[i:], *m.MongoDb)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -1505,7 +2985,7 @@ func (m *IdentifyRequest) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
-func (m *SandboxLocations) Marshal() (data []byte, err error) {
+func (m *LocalExecuteGraphResult) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1515,22 +2995,42 @@ func (m *SandboxLocations) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *SandboxLocations) MarshalTo(data []byte) (int, error) {
+func (m *LocalExecuteGraphResult) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Compile != nil {
-		data[i] = 0xa
-		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.Compile)))
-		i += copy(data[i:], *m.Compile)
+	if len(m.Results) > 0 {
+		for _, msg := range m.Results {
+			data[i] = 0xa
+			i++
+			i = encodeVarintLocal(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.Run != nil {
+	if m.Transcript != nil {
 		data[i] = 0x12
 		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.Run)))
-		i += copy(data[i:], *m.Run)
+		i = encodeVarintLocal(data, i, uint64(m.Transcript.Size()))
+		n20, err := m.Transcript.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if m.TranscriptTruncated != nil {
+		data[i] = 0x18
+		i++
+		if *m.TranscriptTruncated {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -1538,7 +3038,7 @@ func (m *SandboxLocations) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
-func (m *IdentifyResponse) Marshal() (data []byte, err error) {
+func (m *LocalExecution) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1548,80 +3048,32 @@ func (m *IdentifyResponse) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *IdentifyResponse) MarshalTo(data []byte) (int, error) {
+func (m *LocalExecution) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.InvokerId != nil {
+	if m.Parameters == nil {
+		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+	} else {
 		data[i] = 0xa
 		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.InvokerId)))
-		i += copy(data[i:], *m.InvokerId)
-	}
-	if len(m.Sandboxes) > 0 {
-		for _, msg := range m.Sandboxes {
-			data[i] = 0x12
-			i++
-			i = encodeVarintLocal(data, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(data[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+		i = encodeVarintLocal(data, i, uint64(m.Parameters.Size()))
+		n21, err := m.Parameters.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n21
 	}
-	if m.Environment != nil {
-		data[i] = 0x1a
+	if m.Result != nil {
+		data[i] = 0x12
 		i++
-		i = encodeVarintLocal(data, i, uint64(m.Environment.Size()))
-		n15, err := m.Environment.MarshalTo(data[i:])
+		i = encodeVarintLocal(data, i, uint64(m.Result.Size()))
+		n22, err := m.Result.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
-	}
-	if m.Platform != nil {
-		data[i] = 0x22
-		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.Platform)))
-		i += copy(data[i:], *m.Platform)
-	}
-	if m.PathSeparator != nil {
-		data[i] = 0x2a
-		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.PathSeparator)))
-		i += copy(data[i:], *m.PathSeparator)
-	}
-	if len(m.Disks) > 0 {
-		for _, s := range m.Disks {
-			data[i] = 0x32
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				data[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			data[i] = uint8(l)
-			i++
-			i += copy(data[i:], s)
-		}
-	}
-	if len(m.ProgramFiles) > 0 {
-		for _, s := range m.ProgramFiles {
-			data[i] = 0x3a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				data[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			data[i] = uint8(l)
-			i++
-			i += copy(data[i:], s)
-		}
+		i += n22
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -1629,7 +3081,7 @@ func (m *IdentifyResponse) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
-func (m *FileStat) Marshal() (data []byte, err error) {
+func (m *BinaryTypeRequest) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1639,37 +3091,16 @@ func (m *FileStat) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *FileStat) MarshalTo(data []byte) (int, error) {
+func (m *BinaryTypeRequest) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Name != nil {
+	if m.Pathname != nil {
 		data[i] = 0xa
 		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.Name)))
-		i += copy(data[i:], *m.Name)
-	}
-	if m.IsDirectory != nil {
-		data[i] = 0x10
-		i++
-		if *m.IsDirectory {
-			data[i] = 1
-		} else {
-			data[i] = 0
-		}
-		i++
-	}
-	if m.Size_ != nil {
-		data[i] = 0x18
-		i++
-		i = encodeVarintLocal(data, i, uint64(*m.Size_))
-	}
-	if m.Checksum != nil {
-		data[i] = 0x22
-		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.Checksum)))
-		i += copy(data[i:], *m.Checksum)
+		i = encodeVarintLocal(data, i, uint64(len(*m.Pathname)))
+		i += copy(data[i:], *m.Pathname)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -1677,7 +3108,7 @@ func (m *FileStat) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
-func (m *StatRequest) Marshal() (data []byte, err error) {
+func (m *BinaryTypeResponse) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1687,51 +3118,25 @@ func (m *StatRequest) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *StatRequest) MarshalTo(data []byte) (int, error) {
+func (m *BinaryTypeResponse) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		for _, s := range m.Name {
-			data[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				data[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			data[i] = uint8(l)
-			i++
-			i += copy(data[i:], s)
-		}
-	}
-	if m.SandboxId != nil {
-		data[i] = 0x12
-		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.SandboxId)))
-		i += copy(data[i:], *m.SandboxId)
-	}
-	if m.Expand != nil {
-		data[i] = 0x18
+	if m.Failure != nil {
+		data[i] = 0x8
 		i++
-		if *m.Expand {
+		if *m.Failure {
 			data[i] = 1
 		} else {
 			data[i] = 0
 		}
 		i++
 	}
-	if m.CalculateChecksum != nil {
-		data[i] = 0x20
-		i++
-		if *m.CalculateChecksum {
-			data[i] = 1
-		} else {
-			data[i] = 0
-		}
+	if m.Result != nil {
+		data[i] = 0x10
 		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Result))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -1739,7 +3144,7 @@ func (m *StatRequest) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
-func (m *FileStats) Marshal() (data []byte, err error) {
+func (m *ClearSandboxRequest) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1749,22 +3154,22 @@ func (m *FileStats) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *FileStats) MarshalTo(data []byte) (int, error) {
+func (m *ClearSandboxRequest) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for _, msg := range m.Entries {
-			data[i] = 0xa
-			i++
-			i = encodeVarintLocal(data, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(data[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.Sandbox != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Sandbox)))
+		i += copy(data[i:], *m.Sandbox)
+	}
+	if m.Lease != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Lease)))
+		i += copy(data[i:], *m.Lease)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -1772,7 +3177,7 @@ func (m *FileStats) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetRequest) Marshal() (data []byte, err error) {
+func (m *AcquireSandboxRequest) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1782,18 +3187,20 @@ func (m *GetRequest) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *GetRequest) MarshalTo(data []byte) (int, error) {
+func (m *AcquireSandboxRequest) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Name == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
-	} else {
-		data[i] = 0xa
+	if m.Index != nil {
+		data[i] = 0x8
 		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.Name)))
-		i += copy(data[i:], *m.Name)
+		i = encodeVarintLocal(data, i, uint64(*m.Index))
+	}
+	if m.DurationMicros != nil {
+		data[i] = 0x10
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.DurationMicros))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -1801,7 +3208,7 @@ func (m *GetRequest) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
-func (m *EmptyMessage) Marshal() (data []byte, err error) {
+func (m *SandboxLease) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1811,18 +3218,34 @@ func (m *EmptyMessage) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *EmptyMessage) MarshalTo(data []byte) (int, error) {
+func (m *SandboxLease) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Index != nil {
+		data[i] = 0x8
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Index))
+	}
+	if m.Token != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Token)))
+		i += copy(data[i:], *m.Token)
+	}
+	if m.ExpiresUnixMicros != nil {
+		data[i] = 0x18
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.ExpiresUnixMicros))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *CopyOperation) Marshal() (data []byte, err error) {
+func (m *RenewLeaseRequest) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1832,50 +3255,21 @@ func (m *CopyOperation) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *CopyOperation) MarshalTo(data []byte) (int, error) {
+func (m *RenewLeaseRequest) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.LocalFileName != nil {
+	if m.Token != nil {
 		data[i] = 0xa
 		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.LocalFileName)))
-		i += copy(data[i:], *m.LocalFileName)
-	}
-	if m.RemoteLocation != nil {
-		data[i] = 0x12
-		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.RemoteLocation)))
-		i += copy(data[i:], *m.RemoteLocation)
-	}
-	if m.Upload != nil {
-		data[i] = 0x18
-		i++
-		if *m.Upload {
-			data[i] = 1
-		} else {
-			data[i] = 0
-		}
-		i++
-	}
-	if m.Checksum != nil {
-		data[i] = 0x22
-		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.Checksum)))
-		i += copy(data[i:], *m.Checksum)
+		i = encodeVarintLocal(data, i, uint64(len(*m.Token)))
+		i += copy(data[i:], *m.Token)
 	}
-	if m.ModuleType != nil {
-		data[i] = 0x2a
-		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.ModuleType)))
-		i += copy(data[i:], *m.ModuleType)
-	}
-	if m.AuthorizationToken != nil {
-		data[i] = 0x32
+	if m.DurationMicros != nil {
+		data[i] = 0x10
 		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.AuthorizationToken)))
-		i += copy(data[i:], *m.AuthorizationToken)
+		i = encodeVarintLocal(data, i, uint64(*m.DurationMicros))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -1883,7 +3277,7 @@ func (m *CopyOperation) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
-func (m *CopyOperations) Marshal() (data []byte, err error) {
+func (m *ReleaseSandboxRequest) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1893,28 +3287,26 @@ func (m *CopyOperations) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *CopyOperations) MarshalTo(data []byte) (int, error) {
+func (m *ReleaseSandboxRequest) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for _, msg := range m.Entries {
-			data[i] = 0xa
-			i++
-			i = encodeVarintLocal(data, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(data[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.Token != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Token)))
+		i += copy(data[i:], *m.Token)
 	}
-	if m.SandboxId != nil {
-		data[i] = 0x12
+	if m.Clear != nil {
+		data[i] = 0x10
+		i++
+		if *m.Clear {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
 		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.SandboxId)))
-		i += copy(data[i:], *m.SandboxId)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -1922,7 +3314,7 @@ func (m *CopyOperations) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
-func (m *NamePair) Marshal() (data []byte, err error) {
+func (m *SnapshotRequest) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1932,34 +3324,36 @@ func (m *NamePair) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *NamePair) MarshalTo(data []byte) (int, error) {
+func (m *SnapshotRequest) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Source == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
-	} else {
+	if m.Source != nil {
 		data[i] = 0xa
 		i++
 		i = encodeVarintLocal(data, i, uint64(len(*m.Source)))
 		i += copy(data[i:], *m.Source)
 	}
-	if m.Destination == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
-	} else {
+	if m.Destination != nil {
 		data[i] = 0x12
 		i++
 		i = encodeVarintLocal(data, i, uint64(len(*m.Destination)))
 		i += copy(data[i:], *m.Destination)
 	}
+	if m.Lease != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Lease)))
+		i += copy(data[i:], *m.Lease)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *RepeatedNamePairEntries) Marshal() (data []byte, err error) {
+func (m *IdentifyRequest) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1969,28 +3363,28 @@ func (m *RepeatedNamePairEntries) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *RepeatedNamePairEntries) MarshalTo(data []byte) (int, error) {
+func (m *IdentifyRequest) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for _, msg := range m.Entries {
-			data[i] = 0xa
-			i++
-			i = encodeVarintLocal(data, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(data[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.ContesterId != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.ContesterId)))
+		i += copy(data[i:], *m.ContesterId)
 	}
-	if m.SandboxId != nil {
+	if m.MongoHost != nil {
 		data[i] = 0x12
 		i++
-		i = encodeVarintLocal(data, i, uint64(len(*m.SandboxId)))
-		i += copy(data[i:], *m.SandboxId)
+		i = encodeVarintLocal(data, i, uint64(len(*m.MongoHost)))
+		i += copy(data[i:], *m.MongoHost)
+	}
+	if m.MongoDb != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.MongoDb)))
+		i += copy(data[i:], *m.MongoDb)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -1998,7 +3392,7 @@ func (m *RepeatedNamePairEntries) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RepeatedStringEntries) Marshal() (data []byte, err error) {
+func (m *DiskUsage) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -2008,25 +3402,20 @@ func (m *RepeatedStringEntries) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *RepeatedStringEntries) MarshalTo(data []byte) (int, error) {
+func (m *DiskUsage) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for _, s := range m.Entries {
-			data[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				data[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			data[i] = uint8(l)
-			i++
-			i += copy(data[i:], s)
-		}
+	if m.Quota != nil {
+		data[i] = 0x8
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Quota))
+	}
+	if m.Used != nil {
+		data[i] = 0x10
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Used))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -2034,556 +3423,5706 @@ func (m *RepeatedStringEntries) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
-func encodeFixed64Local(data []byte, offset int, v uint64) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	data[offset+4] = uint8(v >> 32)
-	data[offset+5] = uint8(v >> 40)
-	data[offset+6] = uint8(v >> 48)
-	data[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Local(data []byte, offset int, v uint32) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintLocal(data []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		data[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SandboxLocations) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	data[offset] = uint8(v)
-	return offset + 1
+	return data[:n], nil
 }
-func (m *LocalEnvironment) Size() (n int) {
+
+func (m *SandboxLocations) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Empty != nil {
-		n += 2
+	if m.Compile != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Compile)))
+		i += copy(data[i:], *m.Compile)
 	}
-	if len(m.Variable) > 0 {
-		for _, e := range m.Variable {
-			l = e.Size()
-			n += 1 + l + sovLocal(uint64(l))
+	if m.Run != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Run)))
+		i += copy(data[i:], *m.Run)
+	}
+	if m.CompileDisk != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintLocal(data, i, uint64(m.CompileDisk.Size()))
+		n23, err := m.CompileDisk.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n23
+	}
+	if m.RunDisk != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintLocal(data, i, uint64(m.RunDisk.Size()))
+		n24, err := m.RunDisk.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(data[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *LocalEnvironment_Variable) Size() (n int) {
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if m.Value != nil {
-		l = len(*m.Value)
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if m.Expand != nil {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *IdentifyResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *LocalExecutionParameters) Size() (n int) {
+func (m *IdentifyResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ApplicationName != nil {
-		l = len(*m.ApplicationName)
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if m.CommandLine != nil {
-		l = len(*m.CommandLine)
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if m.CurrentDirectory != nil {
-		l = len(*m.CurrentDirectory)
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if m.TimeLimitMicros != nil {
-		n += 1 + sovLocal(uint64(*m.TimeLimitMicros))
-	}
-	if m.MemoryLimit != nil {
-		n += 1 + sovLocal(uint64(*m.MemoryLimit))
+	if m.InvokerId != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.InvokerId)))
+		i += copy(data[i:], *m.InvokerId)
 	}
-	if m.CheckIdleness != nil {
-		n += 2
+	if len(m.Sandboxes) > 0 {
+		for _, msg := range m.Sandboxes {
+			data[i] = 0x12
+			i++
+			i = encodeVarintLocal(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.Environment != nil {
-		l = m.Environment.Size()
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if m.RestrictUi != nil {
-		n += 2
-	}
-	if m.NoJob != nil {
-		n += 2
-	}
-	if m.ProcessLimit != nil {
-		n += 1 + sovLocal(uint64(*m.ProcessLimit))
-	}
-	if m.StdIn != nil {
-		l = m.StdIn.Size()
-		n += 1 + l + sovLocal(uint64(l))
+		data[i] = 0x1a
+		i++
+		i = encodeVarintLocal(data, i, uint64(m.Environment.Size()))
+		n25, err := m.Environment.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
 	}
-	if m.StdOut != nil {
-		l = m.StdOut.Size()
-		n += 1 + l + sovLocal(uint64(l))
+	if m.Platform != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Platform)))
+		i += copy(data[i:], *m.Platform)
 	}
-	if m.StdErr != nil {
-		l = m.StdErr.Size()
-		n += 1 + l + sovLocal(uint64(l))
+	if m.PathSeparator != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.PathSeparator)))
+		i += copy(data[i:], *m.PathSeparator)
 	}
-	if m.TimeLimitHardMicros != nil {
-		n += 1 + sovLocal(uint64(*m.TimeLimitHardMicros))
+	if len(m.Disks) > 0 {
+		for _, s := range m.Disks {
+			data[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
 	}
-	if len(m.CommandLineParameters) > 0 {
-		for _, s := range m.CommandLineParameters {
+	if len(m.ProgramFiles) > 0 {
+		for _, s := range m.ProgramFiles {
+			data[i] = 0x3a
+			i++
 			l = len(s)
-			n += 2 + l + sovLocal(uint64(l))
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
 		}
 	}
-	if m.SandboxId != nil {
-		l = len(*m.SandboxId)
-		n += 2 + l + sovLocal(uint64(l))
+	if m.SpeedFactor != nil {
+		data[i] = 0x41
+		i++
+		i = encodeFixed64Local(data, i, uint64(math.Float64bits(float64(*m.SpeedFactor))))
 	}
-	if m.JoinStdoutStderr != nil {
-		n += 3
+	if m.DownloadCache != nil {
+		data[i] = 0x4a
+		i++
+		i = encodeVarintLocal(data, i, uint64(m.DownloadCache.Size()))
+		n26, err := m.DownloadCache.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	if m.CalibrationError != nil {
+		data[i] = 0x52
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.CalibrationError)))
+		i += copy(data[i:], *m.CalibrationError)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(data[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *LocalExecuteConnected) Size() (n int) {
-	var l int
-	_ = l
-	if m.First != nil {
-		l = m.First.Size()
-		n += 1 + l + sovLocal(uint64(l))
+func (m *DownloadCacheStats) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	if m.Second != nil {
-		l = m.Second.Size()
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return data[:n], nil
 }
 
-func (m *LocalExecutionResult) Size() (n int) {
+func (m *DownloadCacheStats) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Flags != nil {
-		l = m.Flags.Size()
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if m.Time != nil {
-		l = m.Time.Size()
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if m.Memory != nil {
-		n += 1 + sovLocal(uint64(*m.Memory))
-	}
-	if m.ReturnCode != nil {
-		n += 1 + sovLocal(uint64(*m.ReturnCode))
+	if m.Hits != nil {
+		data[i] = 0x8
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Hits))
 	}
-	if m.StdOut != nil {
-		l = m.StdOut.Size()
-		n += 1 + l + sovLocal(uint64(l))
+	if m.Misses != nil {
+		data[i] = 0x10
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Misses))
 	}
-	if m.StdErr != nil {
-		l = m.StdErr.Size()
-		n += 1 + l + sovLocal(uint64(l))
+	if m.Evictions != nil {
+		data[i] = 0x18
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Evictions))
 	}
-	if m.TotalProcesses != nil {
-		n += 1 + sovLocal(uint64(*m.TotalProcesses))
+	if m.Entries != nil {
+		data[i] = 0x20
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Entries))
 	}
-	if m.KillSignal != nil {
-		n += 1 + sovLocal(uint64(*m.KillSignal))
+	if m.UsedBytes != nil {
+		data[i] = 0x28
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.UsedBytes))
 	}
-	if m.StopSignal != nil {
-		n += 1 + sovLocal(uint64(*m.StopSignal))
+	if m.MaxBytes != nil {
+		data[i] = 0x30
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.MaxBytes))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(data[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *LocalExecuteConnectedResult) Size() (n int) {
-	var l int
-	_ = l
-	if m.First != nil {
-		l = m.First.Size()
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if m.Second != nil {
-		l = m.Second.Size()
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *CalibrateRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *LocalExecution) Size() (n int) {
+func (m *CalibrateRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Parameters != nil {
-		l = m.Parameters.Size()
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if m.Result != nil {
-		l = m.Result.Size()
-		n += 1 + l + sovLocal(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(data[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *BinaryTypeRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Pathname != nil {
-		l = len(*m.Pathname)
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *CalibrateResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *BinaryTypeResponse) Size() (n int) {
+func (m *CalibrateResponse) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Failure != nil {
-		n += 2
-	}
-	if m.Result != nil {
-		n += 1 + sovLocal(uint64(*m.Result))
+	if m.SpeedFactor != nil {
+		data[i] = 0x9
+		i++
+		i = encodeFixed64Local(data, i, uint64(math.Float64bits(float64(*m.SpeedFactor))))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(data[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ClearSandboxRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Sandbox != nil {
-		l = len(*m.Sandbox)
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *FileStat) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *IdentifyRequest) Size() (n int) {
+func (m *FileStat) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ContesterId != nil {
-		l = len(*m.ContesterId)
-		n += 1 + l + sovLocal(uint64(l))
+	if m.Name != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Name)))
+		i += copy(data[i:], *m.Name)
 	}
-	if m.MongoHost != nil {
-		l = len(*m.MongoHost)
-		n += 1 + l + sovLocal(uint64(l))
+	if m.IsDirectory != nil {
+		data[i] = 0x10
+		i++
+		if *m.IsDirectory {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
 	}
-	if m.MongoDb != nil {
-		l = len(*m.MongoDb)
-		n += 1 + l + sovLocal(uint64(l))
+	if m.Size_ != nil {
+		data[i] = 0x18
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Size_))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Checksum != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Checksum)))
+		i += copy(data[i:], *m.Checksum)
 	}
-	return n
-}
-
-func (m *SandboxLocations) Size() (n int) {
-	var l int
-	_ = l
-	if m.Compile != nil {
-		l = len(*m.Compile)
-		n += 1 + l + sovLocal(uint64(l))
+	if m.Mode != nil {
+		data[i] = 0x28
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Mode))
 	}
-	if m.Run != nil {
-		l = len(*m.Run)
-		n += 1 + l + sovLocal(uint64(l))
+	if m.MtimeMicros != nil {
+		data[i] = 0x30
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.MtimeMicros))
+	}
+	if m.Uid != nil {
+		data[i] = 0x38
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Uid))
+	}
+	if m.Gid != nil {
+		data[i] = 0x40
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Gid))
+	}
+	if m.SymlinkTarget != nil {
+		data[i] = 0x4a
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.SymlinkTarget)))
+		i += copy(data[i:], *m.SymlinkTarget)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(data[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *IdentifyResponse) Size() (n int) {
+func (m *StatRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *StatRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.InvokerId != nil {
-		l = len(*m.InvokerId)
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if len(m.Sandboxes) > 0 {
-		for _, e := range m.Sandboxes {
-			l = e.Size()
-			n += 1 + l + sovLocal(uint64(l))
+	if len(m.Name) > 0 {
+		for _, s := range m.Name {
+			data[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
 		}
 	}
-	if m.Environment != nil {
-		l = m.Environment.Size()
-		n += 1 + l + sovLocal(uint64(l))
+	if m.SandboxId != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.SandboxId)))
+		i += copy(data[i:], *m.SandboxId)
 	}
-	if m.Platform != nil {
-		l = len(*m.Platform)
-		n += 1 + l + sovLocal(uint64(l))
+	if m.Expand != nil {
+		data[i] = 0x18
+		i++
+		if *m.Expand {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
 	}
-	if m.PathSeparator != nil {
-		l = len(*m.PathSeparator)
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if len(m.Disks) > 0 {
-		for _, s := range m.Disks {
-			l = len(s)
-			n += 1 + l + sovLocal(uint64(l))
-		}
-	}
-	if len(m.ProgramFiles) > 0 {
-		for _, s := range m.ProgramFiles {
-			l = len(s)
-			n += 1 + l + sovLocal(uint64(l))
+	if m.CalculateChecksum != nil {
+		data[i] = 0x20
+		i++
+		if *m.CalculateChecksum {
+			data[i] = 1
+		} else {
+			data[i] = 0
 		}
+		i++
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Lease != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Lease)))
+		i += copy(data[i:], *m.Lease)
 	}
-	return n
-}
-
-func (m *FileStat) Size() (n int) {
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovLocal(uint64(l))
+	if m.Recursive != nil {
+		data[i] = 0x30
+		i++
+		if *m.Recursive {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
 	}
-	if m.IsDirectory != nil {
-		n += 2
+	if m.MaxDepth != nil {
+		data[i] = 0x38
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.MaxDepth))
 	}
-	if m.Size_ != nil {
-		n += 1 + sovLocal(uint64(*m.Size_))
+	if m.MaxEntries != nil {
+		data[i] = 0x40
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.MaxEntries))
 	}
-	if m.Checksum != nil {
-		l = len(*m.Checksum)
-		n += 1 + l + sovLocal(uint64(l))
+	if m.ChecksumAlgorithm != nil {
+		data[i] = 0x4a
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.ChecksumAlgorithm)))
+		i += copy(data[i:], *m.ChecksumAlgorithm)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(data[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *StatRequest) Size() (n int) {
+func (m *FileStats) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *FileStats) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		for _, s := range m.Name {
-			l = len(s)
-			n += 1 + l + sovLocal(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, msg := range m.Entries {
+			data[i] = 0xa
+			i++
+			i = encodeVarintLocal(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if m.SandboxId != nil {
-		l = len(*m.SandboxId)
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if m.Expand != nil {
-		n += 2
+	if m.Truncated != nil {
+		data[i] = 0x10
+		i++
+		if *m.Truncated {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
 	}
-	if m.CalculateChecksum != nil {
-		n += 2
+	if len(m.CopyResults) > 0 {
+		for _, msg := range m.CopyResults {
+			data[i] = 0x1a
+			i++
+			i = encodeVarintLocal(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(data[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *FileStats) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovLocal(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *GetRequest) Size() (n int) {
+func (m *GetRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovLocal(uint64(l))
+	if m.Name == nil {
+		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+	} else {
+		data[i] = 0xa
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Name)))
+		i += copy(data[i:], *m.Name)
+	}
+	if m.Lease != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Lease)))
+		i += copy(data[i:], *m.Lease)
+	}
+	if m.ChecksumAlgorithm != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.ChecksumAlgorithm)))
+		i += copy(data[i:], *m.ChecksumAlgorithm)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(data[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *EmptyMessage) Size() (n int) {
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *PutChunkRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *CopyOperation) Size() (n int) {
+func (m *PutChunkRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.LocalFileName != nil {
-		l = len(*m.LocalFileName)
-		n += 1 + l + sovLocal(uint64(l))
+	if m.Name == nil {
+		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+	} else {
+		data[i] = 0xa
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Name)))
+		i += copy(data[i:], *m.Name)
 	}
-	if m.RemoteLocation != nil {
-		l = len(*m.RemoteLocation)
-		n += 1 + l + sovLocal(uint64(l))
+	if m.Offset != nil {
+		data[i] = 0x10
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Offset))
 	}
-	if m.Upload != nil {
-		n += 2
+	if m.Data != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintLocal(data, i, uint64(m.Data.Size()))
+		n27, err := m.Data.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
 	}
-	if m.Checksum != nil {
-		l = len(*m.Checksum)
-		n += 1 + l + sovLocal(uint64(l))
+	if m.Final != nil {
+		data[i] = 0x20
+		i++
+		if *m.Final {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
 	}
-	if m.ModuleType != nil {
-		l = len(*m.ModuleType)
-		n += 1 + l + sovLocal(uint64(l))
+	if m.Sha1 != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(m.Sha1)))
+		i += copy(data[i:], m.Sha1)
 	}
-	if m.AuthorizationToken != nil {
-		l = len(*m.AuthorizationToken)
-		n += 1 + l + sovLocal(uint64(l))
+	if m.Lease != nil {
+		data[i] = 0x32
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Lease)))
+		i += copy(data[i:], *m.Lease)
+	}
+	if m.Checksum != nil {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Checksum)))
+		i += copy(data[i:], *m.Checksum)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(data[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CopyOperations) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovLocal(uint64(l))
-		}
-	}
-	if m.SandboxId != nil {
-		l = len(*m.SandboxId)
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetChunkRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *NamePair) Size() (n int) {
+func (m *GetChunkRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Source != nil {
-		l = len(*m.Source)
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if m.Destination != nil {
-		l = len(*m.Destination)
-		n += 1 + l + sovLocal(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Name == nil {
+		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+	} else {
+		data[i] = 0xa
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Name)))
+		i += copy(data[i:], *m.Name)
 	}
-	return n
+	if m.Offset != nil {
+		data[i] = 0x10
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Offset))
+	}
+	if m.Length != nil {
+		data[i] = 0x18
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Length))
+	}
+	if m.Lease != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Lease)))
+		i += copy(data[i:], *m.Lease)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
 }
 
-func (m *RepeatedNamePairEntries) Size() (n int) {
+func (m *FileChunk) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *FileChunk) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovLocal(uint64(l))
+	if m.Offset != nil {
+		data[i] = 0x8
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Offset))
+	}
+	if m.Data != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintLocal(data, i, uint64(m.Data.Size()))
+		n28, err := m.Data.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n28
 	}
-	if m.SandboxId != nil {
-		l = len(*m.SandboxId)
-		n += 1 + l + sovLocal(uint64(l))
+	if m.FileSize != nil {
+		data[i] = 0x18
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.FileSize))
+	}
+	if m.Eof != nil {
+		data[i] = 0x20
+		i++
+		if *m.Eof {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Sha1 != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(m.Sha1)))
+		i += copy(data[i:], m.Sha1)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(data[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RepeatedStringEntries) Size() (n int) {
+func (m *PutArchiveRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PutArchiveRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for _, s := range m.Entries {
-			l = len(s)
-			n += 1 + l + sovLocal(uint64(l))
+	if m.Destination == nil {
+		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+	} else {
+		data[i] = 0xa
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Destination)))
+		i += copy(data[i:], *m.Destination)
+	}
+	if m.Data != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintLocal(data, i, uint64(m.Data.Size()))
+		n29, err := m.Data.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	if m.Format != nil {
+		data[i] = 0x18
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Format))
+	}
+	if m.Lease != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Lease)))
+		i += copy(data[i:], *m.Lease)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetArchiveRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GetArchiveRequest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+	} else {
+		data[i] = 0xa
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Name)))
+		i += copy(data[i:], *m.Name)
+	}
+	if m.Expand != nil {
+		data[i] = 0x10
+		i++
+		if *m.Expand {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Format != nil {
+		data[i] = 0x18
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Format))
+	}
+	if m.Lease != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Lease)))
+		i += copy(data[i:], *m.Lease)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Archive) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Archive) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintLocal(data, i, uint64(m.Data.Size()))
+		n30, err := m.Data.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	if m.Format != nil {
+		data[i] = 0x10
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Format))
+	}
+	if m.Files != nil {
+		data[i] = 0x18
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Files))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *EmptyMessage) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *EmptyMessage) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CopyOperation) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *CopyOperation) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.LocalFileName != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.LocalFileName)))
+		i += copy(data[i:], *m.LocalFileName)
+	}
+	if m.RemoteLocation != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.RemoteLocation)))
+		i += copy(data[i:], *m.RemoteLocation)
+	}
+	if m.Upload != nil {
+		data[i] = 0x18
+		i++
+		if *m.Upload {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Checksum != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Checksum)))
+		i += copy(data[i:], *m.Checksum)
+	}
+	if m.ModuleType != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.ModuleType)))
+		i += copy(data[i:], *m.ModuleType)
+	}
+	if m.AuthorizationToken != nil {
+		data[i] = 0x32
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.AuthorizationToken)))
+		i += copy(data[i:], *m.AuthorizationToken)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CopyOperations) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *CopyOperations) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, msg := range m.Entries {
+			data[i] = 0xa
+			i++
+			i = encodeVarintLocal(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.SandboxId != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.SandboxId)))
+		i += copy(data[i:], *m.SandboxId)
+	}
+	if m.Lease != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Lease)))
+		i += copy(data[i:], *m.Lease)
+	}
+	if m.Parallelism != nil {
+		data[i] = 0x20
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Parallelism))
+	}
+	if m.FailFast != nil {
+		data[i] = 0x28
+		i++
+		if *m.FailFast {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CopyResult) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *CopyResult) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Status != nil {
+		data[i] = 0x8
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Status))
+	}
+	if m.Error != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Error)))
+		i += copy(data[i:], *m.Error)
+	}
+	if m.Bytes != nil {
+		data[i] = 0x18
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.Bytes))
+	}
+	if m.DurationMicros != nil {
+		data[i] = 0x20
+		i++
+		i = encodeVarintLocal(data, i, uint64(*m.DurationMicros))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *NamePair) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NamePair) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Source == nil {
+		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+	} else {
+		data[i] = 0xa
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Source)))
+		i += copy(data[i:], *m.Source)
+	}
+	if m.Destination == nil {
+		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+	} else {
+		data[i] = 0x12
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Destination)))
+		i += copy(data[i:], *m.Destination)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RepeatedNamePairEntries) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RepeatedNamePairEntries) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, msg := range m.Entries {
+			data[i] = 0xa
+			i++
+			i = encodeVarintLocal(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.SandboxId != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.SandboxId)))
+		i += copy(data[i:], *m.SandboxId)
+	}
+	if m.Lease != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Lease)))
+		i += copy(data[i:], *m.Lease)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RepeatedStringEntries) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RepeatedStringEntries) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, s := range m.Entries {
+			data[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if m.Lease != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintLocal(data, i, uint64(len(*m.Lease)))
+		i += copy(data[i:], *m.Lease)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeFixed64Local(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Local(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintLocal(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *LocalEnvironment) Size() (n int) {
+	var l int
+	_ = l
+	if m.Empty != nil {
+		n += 2
+	}
+	if len(m.Variable) > 0 {
+		for _, e := range m.Variable {
+			l = e.Size()
+			n += 1 + l + sovLocal(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LocalEnvironment_Variable) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Value != nil {
+		l = len(*m.Value)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Expand != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LocalExecutionParameters) Size() (n int) {
+	var l int
+	_ = l
+	if m.ApplicationName != nil {
+		l = len(*m.ApplicationName)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.CommandLine != nil {
+		l = len(*m.CommandLine)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.CurrentDirectory != nil {
+		l = len(*m.CurrentDirectory)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.TimeLimitMicros != nil {
+		n += 1 + sovLocal(uint64(*m.TimeLimitMicros))
+	}
+	if m.MemoryLimit != nil {
+		n += 1 + sovLocal(uint64(*m.MemoryLimit))
+	}
+	if m.CheckIdleness != nil {
+		n += 2
+	}
+	if m.Environment != nil {
+		l = m.Environment.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.RestrictUi != nil {
+		n += 2
+	}
+	if m.NoJob != nil {
+		n += 2
+	}
+	if m.ProcessLimit != nil {
+		n += 1 + sovLocal(uint64(*m.ProcessLimit))
+	}
+	if m.StdIn != nil {
+		l = m.StdIn.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.StdOut != nil {
+		l = m.StdOut.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.StdErr != nil {
+		l = m.StdErr.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.TimeLimitHardMicros != nil {
+		n += 1 + sovLocal(uint64(*m.TimeLimitHardMicros))
+	}
+	if len(m.CommandLineParameters) > 0 {
+		for _, s := range m.CommandLineParameters {
+			l = len(s)
+			n += 2 + l + sovLocal(uint64(l))
+		}
+	}
+	if m.SandboxId != nil {
+		l = len(*m.SandboxId)
+		n += 2 + l + sovLocal(uint64(l))
+	}
+	if m.JoinStdoutStderr != nil {
+		n += 3
+	}
+	if m.Repeat != nil {
+		n += 2 + sovLocal(uint64(*m.Repeat))
+	}
+	if m.RepeatPolicy != nil {
+		n += 2 + sovLocal(uint64(*m.RepeatPolicy))
+	}
+	if m.NormalizeTime != nil {
+		n += 3
+	}
+	if m.SearchPath != nil {
+		n += 3
+	}
+	if m.Lease != nil {
+		l = len(*m.Lease)
+		n += 2 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LocalExecuteConnected) Size() (n int) {
+	var l int
+	_ = l
+	if m.First != nil {
+		l = m.First.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Second != nil {
+		l = m.Second.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.RecordTranscript != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LocalExecutionResult) Size() (n int) {
+	var l int
+	_ = l
+	if m.Flags != nil {
+		l = m.Flags.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Time != nil {
+		l = m.Time.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Memory != nil {
+		n += 1 + sovLocal(uint64(*m.Memory))
+	}
+	if m.ReturnCode != nil {
+		n += 1 + sovLocal(uint64(*m.ReturnCode))
+	}
+	if m.StdOut != nil {
+		l = m.StdOut.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.StdErr != nil {
+		l = m.StdErr.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.TotalProcesses != nil {
+		n += 1 + sovLocal(uint64(*m.TotalProcesses))
+	}
+	if m.KillSignal != nil {
+		n += 1 + sovLocal(uint64(*m.KillSignal))
+	}
+	if m.StopSignal != nil {
+		n += 1 + sovLocal(uint64(*m.StopSignal))
+	}
+	if m.StdOutTruncated != nil {
+		n += 2
+	}
+	if m.StdErrTruncated != nil {
+		n += 2
+	}
+	if m.RepeatStatistics != nil {
+		l = m.RepeatStatistics.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.NormalizedTime != nil {
+		l = m.NormalizedTime.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.SetupFailure != nil {
+		l = m.SetupFailure.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetupFailure) Size() (n int) {
+	var l int
+	_ = l
+	if m.Stage != nil {
+		l = len(*m.Stage)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Errno != nil {
+		n += 1 + sovLocal(uint64(*m.Errno))
+	}
+	if m.Path != nil {
+		l = len(*m.Path)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.UserError != nil {
+		n += 2
+	}
+	if m.Message != nil {
+		l = len(*m.Message)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepeatStatistics) Size() (n int) {
+	var l int
+	_ = l
+	if m.Runs != nil {
+		n += 1 + sovLocal(uint64(*m.Runs))
+	}
+	if m.UserTimeMicros != nil {
+		l = m.UserTimeMicros.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.WallTimeMicros != nil {
+		l = m.WallTimeMicros.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Memory != nil {
+		l = m.Memory.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepeatStatistics_Range) Size() (n int) {
+	var l int
+	_ = l
+	if m.Min != nil {
+		n += 1 + sovLocal(uint64(*m.Min))
+	}
+	if m.Median != nil {
+		n += 1 + sovLocal(uint64(*m.Median))
+	}
+	if m.Max != nil {
+		n += 1 + sovLocal(uint64(*m.Max))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LocalExecuteConnectedResult) Size() (n int) {
+	var l int
+	_ = l
+	if m.First != nil {
+		l = m.First.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Second != nil {
+		l = m.Second.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Transcript != nil {
+		l = m.Transcript.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.TranscriptTruncated != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LocalExecuteGraph) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Processes) > 0 {
+		for _, e := range m.Processes {
+			l = e.Size()
+			n += 1 + l + sovLocal(uint64(l))
+		}
+	}
+	if len(m.Edges) > 0 {
+		for _, e := range m.Edges {
+			l = e.Size()
+			n += 1 + l + sovLocal(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LocalExecuteGraph_Edge) Size() (n int) {
+	var l int
+	_ = l
+	if m.Source != nil {
+		n += 1 + sovLocal(uint64(*m.Source))
+	}
+	if m.SourceFd != nil {
+		n += 1 + sovLocal(uint64(*m.SourceFd))
+	}
+	if m.Destination != nil {
+		n += 1 + sovLocal(uint64(*m.Destination))
+	}
+	if m.DestinationFd != nil {
+		n += 1 + sovLocal(uint64(*m.DestinationFd))
+	}
+	if m.Record != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LocalExecuteGraphResult) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovLocal(uint64(l))
+		}
+	}
+	if m.Transcript != nil {
+		l = m.Transcript.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.TranscriptTruncated != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LocalExecution) Size() (n int) {
+	var l int
+	_ = l
+	if m.Parameters != nil {
+		l = m.Parameters.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BinaryTypeRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pathname != nil {
+		l = len(*m.Pathname)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BinaryTypeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Failure != nil {
+		n += 2
+	}
+	if m.Result != nil {
+		n += 1 + sovLocal(uint64(*m.Result))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClearSandboxRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Sandbox != nil {
+		l = len(*m.Sandbox)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Lease != nil {
+		l = len(*m.Lease)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AcquireSandboxRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Index != nil {
+		n += 1 + sovLocal(uint64(*m.Index))
+	}
+	if m.DurationMicros != nil {
+		n += 1 + sovLocal(uint64(*m.DurationMicros))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SandboxLease) Size() (n int) {
+	var l int
+	_ = l
+	if m.Index != nil {
+		n += 1 + sovLocal(uint64(*m.Index))
+	}
+	if m.Token != nil {
+		l = len(*m.Token)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.ExpiresUnixMicros != nil {
+		n += 1 + sovLocal(uint64(*m.ExpiresUnixMicros))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenewLeaseRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Token != nil {
+		l = len(*m.Token)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.DurationMicros != nil {
+		n += 1 + sovLocal(uint64(*m.DurationMicros))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReleaseSandboxRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Token != nil {
+		l = len(*m.Token)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Clear != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SnapshotRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Source != nil {
+		l = len(*m.Source)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Destination != nil {
+		l = len(*m.Destination)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Lease != nil {
+		l = len(*m.Lease)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IdentifyRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.ContesterId != nil {
+		l = len(*m.ContesterId)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.MongoHost != nil {
+		l = len(*m.MongoHost)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.MongoDb != nil {
+		l = len(*m.MongoDb)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiskUsage) Size() (n int) {
+	var l int
+	_ = l
+	if m.Quota != nil {
+		n += 1 + sovLocal(uint64(*m.Quota))
+	}
+	if m.Used != nil {
+		n += 1 + sovLocal(uint64(*m.Used))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SandboxLocations) Size() (n int) {
+	var l int
+	_ = l
+	if m.Compile != nil {
+		l = len(*m.Compile)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Run != nil {
+		l = len(*m.Run)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.CompileDisk != nil {
+		l = m.CompileDisk.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.RunDisk != nil {
+		l = m.RunDisk.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IdentifyResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.InvokerId != nil {
+		l = len(*m.InvokerId)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if len(m.Sandboxes) > 0 {
+		for _, e := range m.Sandboxes {
+			l = e.Size()
+			n += 1 + l + sovLocal(uint64(l))
+		}
+	}
+	if m.Environment != nil {
+		l = m.Environment.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Platform != nil {
+		l = len(*m.Platform)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.PathSeparator != nil {
+		l = len(*m.PathSeparator)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if len(m.Disks) > 0 {
+		for _, s := range m.Disks {
+			l = len(s)
+			n += 1 + l + sovLocal(uint64(l))
+		}
+	}
+	if len(m.ProgramFiles) > 0 {
+		for _, s := range m.ProgramFiles {
+			l = len(s)
+			n += 1 + l + sovLocal(uint64(l))
+		}
+	}
+	if m.SpeedFactor != nil {
+		n += 9
+	}
+	if m.DownloadCache != nil {
+		l = m.DownloadCache.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.CalibrationError != nil {
+		l = len(*m.CalibrationError)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DownloadCacheStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.Hits != nil {
+		n += 1 + sovLocal(uint64(*m.Hits))
+	}
+	if m.Misses != nil {
+		n += 1 + sovLocal(uint64(*m.Misses))
+	}
+	if m.Evictions != nil {
+		n += 1 + sovLocal(uint64(*m.Evictions))
+	}
+	if m.Entries != nil {
+		n += 1 + sovLocal(uint64(*m.Entries))
+	}
+	if m.UsedBytes != nil {
+		n += 1 + sovLocal(uint64(*m.UsedBytes))
+	}
+	if m.MaxBytes != nil {
+		n += 1 + sovLocal(uint64(*m.MaxBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CalibrateRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CalibrateResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.SpeedFactor != nil {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FileStat) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.IsDirectory != nil {
+		n += 2
+	}
+	if m.Size_ != nil {
+		n += 1 + sovLocal(uint64(*m.Size_))
+	}
+	if m.Checksum != nil {
+		l = len(*m.Checksum)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Mode != nil {
+		n += 1 + sovLocal(uint64(*m.Mode))
+	}
+	if m.MtimeMicros != nil {
+		n += 1 + sovLocal(uint64(*m.MtimeMicros))
+	}
+	if m.Uid != nil {
+		n += 1 + sovLocal(uint64(*m.Uid))
+	}
+	if m.Gid != nil {
+		n += 1 + sovLocal(uint64(*m.Gid))
+	}
+	if m.SymlinkTarget != nil {
+		l = len(*m.SymlinkTarget)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		for _, s := range m.Name {
+			l = len(s)
+			n += 1 + l + sovLocal(uint64(l))
+		}
+	}
+	if m.SandboxId != nil {
+		l = len(*m.SandboxId)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Expand != nil {
+		n += 2
+	}
+	if m.CalculateChecksum != nil {
+		n += 2
+	}
+	if m.Lease != nil {
+		l = len(*m.Lease)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Recursive != nil {
+		n += 2
+	}
+	if m.MaxDepth != nil {
+		n += 1 + sovLocal(uint64(*m.MaxDepth))
+	}
+	if m.MaxEntries != nil {
+		n += 1 + sovLocal(uint64(*m.MaxEntries))
+	}
+	if m.ChecksumAlgorithm != nil {
+		l = len(*m.ChecksumAlgorithm)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FileStats) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovLocal(uint64(l))
+		}
+	}
+	if m.Truncated != nil {
+		n += 2
+	}
+	if len(m.CopyResults) > 0 {
+		for _, e := range m.CopyResults {
+			l = e.Size()
+			n += 1 + l + sovLocal(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Lease != nil {
+		l = len(*m.Lease)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.ChecksumAlgorithm != nil {
+		l = len(*m.ChecksumAlgorithm)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutChunkRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Offset != nil {
+		n += 1 + sovLocal(uint64(*m.Offset))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Final != nil {
+		n += 2
+	}
+	if m.Sha1 != nil {
+		l = len(m.Sha1)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Lease != nil {
+		l = len(*m.Lease)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Checksum != nil {
+		l = len(*m.Checksum)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetChunkRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Offset != nil {
+		n += 1 + sovLocal(uint64(*m.Offset))
+	}
+	if m.Length != nil {
+		n += 1 + sovLocal(uint64(*m.Length))
+	}
+	if m.Lease != nil {
+		l = len(*m.Lease)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FileChunk) Size() (n int) {
+	var l int
+	_ = l
+	if m.Offset != nil {
+		n += 1 + sovLocal(uint64(*m.Offset))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.FileSize != nil {
+		n += 1 + sovLocal(uint64(*m.FileSize))
+	}
+	if m.Eof != nil {
+		n += 2
+	}
+	if m.Sha1 != nil {
+		l = len(m.Sha1)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutArchiveRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Destination != nil {
+		l = len(*m.Destination)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Format != nil {
+		n += 1 + sovLocal(uint64(*m.Format))
+	}
+	if m.Lease != nil {
+		l = len(*m.Lease)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetArchiveRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Expand != nil {
+		n += 2
+	}
+	if m.Format != nil {
+		n += 1 + sovLocal(uint64(*m.Format))
+	}
+	if m.Lease != nil {
+		l = len(*m.Lease)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Archive) Size() (n int) {
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Format != nil {
+		n += 1 + sovLocal(uint64(*m.Format))
+	}
+	if m.Files != nil {
+		n += 1 + sovLocal(uint64(*m.Files))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EmptyMessage) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CopyOperation) Size() (n int) {
+	var l int
+	_ = l
+	if m.LocalFileName != nil {
+		l = len(*m.LocalFileName)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.RemoteLocation != nil {
+		l = len(*m.RemoteLocation)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Upload != nil {
+		n += 2
+	}
+	if m.Checksum != nil {
+		l = len(*m.Checksum)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.ModuleType != nil {
+		l = len(*m.ModuleType)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.AuthorizationToken != nil {
+		l = len(*m.AuthorizationToken)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CopyOperations) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovLocal(uint64(l))
+		}
+	}
+	if m.SandboxId != nil {
+		l = len(*m.SandboxId)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Lease != nil {
+		l = len(*m.Lease)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Parallelism != nil {
+		n += 1 + sovLocal(uint64(*m.Parallelism))
+	}
+	if m.FailFast != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CopyResult) Size() (n int) {
+	var l int
+	_ = l
+	if m.Status != nil {
+		n += 1 + sovLocal(uint64(*m.Status))
+	}
+	if m.Error != nil {
+		l = len(*m.Error)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Bytes != nil {
+		n += 1 + sovLocal(uint64(*m.Bytes))
+	}
+	if m.DurationMicros != nil {
+		n += 1 + sovLocal(uint64(*m.DurationMicros))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NamePair) Size() (n int) {
+	var l int
+	_ = l
+	if m.Source != nil {
+		l = len(*m.Source)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Destination != nil {
+		l = len(*m.Destination)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepeatedNamePairEntries) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovLocal(uint64(l))
+		}
+	}
+	if m.SandboxId != nil {
+		l = len(*m.SandboxId)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.Lease != nil {
+		l = len(*m.Lease)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepeatedStringEntries) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, s := range m.Entries {
+			l = len(s)
+			n += 1 + l + sovLocal(uint64(l))
+		}
+	}
+	if m.Lease != nil {
+		l = len(*m.Lease)
+		n += 1 + l + sovLocal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovLocal(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozLocal(x uint64) (n int) {
+	return sovLocal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LocalEnvironment) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocalEnvironment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocalEnvironment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Empty", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Empty = &b
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Variable = append(m.Variable, &LocalEnvironment_Variable{})
+			if err := m.Variable[len(m.Variable)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LocalEnvironment_Variable) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Variable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Variable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Value = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expand", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Expand = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LocalExecutionParameters) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocalExecutionParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocalExecutionParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.ApplicationName = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandLine", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.CommandLine = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentDirectory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.CurrentDirectory = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLimitMicros", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeLimitMicros = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryLimit", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MemoryLimit = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckIdleness", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.CheckIdleness = &b
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Environment == nil {
+				m.Environment = &LocalEnvironment{}
+			}
+			if err := m.Environment.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictUi", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.RestrictUi = &b
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoJob", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.NoJob = &b
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessLimit", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProcessLimit = &v
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StdIn == nil {
+				m.StdIn = &RedirectParameters{}
+			}
+			if err := m.StdIn.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StdOut == nil {
+				m.StdOut = &RedirectParameters{}
+			}
+			if err := m.StdOut.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdErr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StdErr == nil {
+				m.StdErr = &RedirectParameters{}
+			}
+			if err := m.StdErr.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLimitHardMicros", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeLimitHardMicros = &v
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandLineParameters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommandLineParameters = append(m.CommandLineParameters, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SandboxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.SandboxId = &s
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinStdoutStderr", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.JoinStdoutStderr = &b
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repeat", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Repeat = &v
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatPolicy", wireType)
+			}
+			var v LocalExecutionParameters_RepeatPolicy
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (LocalExecutionParameters_RepeatPolicy(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RepeatPolicy = &v
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizeTime", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.NormalizeTime = &b
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchPath", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.SearchPath = &b
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Lease = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LocalExecuteConnected) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocalExecuteConnected: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocalExecuteConnected: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.First == nil {
+				m.First = &LocalExecutionParameters{}
+			}
+			if err := m.First.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Second", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Second == nil {
+				m.Second = &LocalExecutionParameters{}
+			}
+			if err := m.Second.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordTranscript", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.RecordTranscript = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LocalExecutionResult) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocalExecutionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocalExecutionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flags == nil {
+				m.Flags = &ExecutionResultFlags{}
+			}
+			if err := m.Flags.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = &ExecutionResultTime{}
+			}
+			if err := m.Time.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Memory = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnCode", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReturnCode = &v
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StdOut == nil {
+				m.StdOut = &Blob{}
+			}
+			if err := m.StdOut.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdErr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StdErr == nil {
+				m.StdErr = &Blob{}
+			}
+			if err := m.StdErr.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalProcesses", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TotalProcesses = &v
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KillSignal", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KillSignal = &v
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopSignal", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StopSignal = &v
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdOutTruncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.StdOutTruncated = &b
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdErrTruncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.StdErrTruncated = &b
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatStatistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RepeatStatistics == nil {
+				m.RepeatStatistics = &RepeatStatistics{}
+			}
+			if err := m.RepeatStatistics.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NormalizedTime == nil {
+				m.NormalizedTime = &ExecutionResultTime{}
+			}
+			if err := m.NormalizedTime.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetupFailure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SetupFailure == nil {
+				m.SetupFailure = &SetupFailure{}
+			}
+			if err := m.SetupFailure.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetupFailure) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetupFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetupFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Stage = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errno", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Errno = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Path = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserError", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.UserError = &b
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Message = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepeatStatistics) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepeatStatistics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepeatStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Runs = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserTimeMicros", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UserTimeMicros == nil {
+				m.UserTimeMicros = &RepeatStatistics_Range{}
+			}
+			if err := m.UserTimeMicros.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WallTimeMicros", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WallTimeMicros == nil {
+				m.WallTimeMicros = &RepeatStatistics_Range{}
+			}
+			if err := m.WallTimeMicros.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Memory == nil {
+				m.Memory = &RepeatStatistics_Range{}
+			}
+			if err := m.Memory.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepeatStatistics_Range) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Range: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Range: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Min = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Median = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Max = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LocalExecuteConnectedResult) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocalExecuteConnectedResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocalExecuteConnectedResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.First == nil {
+				m.First = &LocalExecutionResult{}
+			}
+			if err := m.First.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Second", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Second == nil {
+				m.Second = &LocalExecutionResult{}
+			}
+			if err := m.Second.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transcript", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transcript == nil {
+				m.Transcript = &Blob{}
+			}
+			if err := m.Transcript.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TranscriptTruncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.TranscriptTruncated = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LocalExecuteGraph) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocalExecuteGraph: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocalExecuteGraph: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Processes = append(m.Processes, &LocalExecutionParameters{})
+			if err := m.Processes[len(m.Processes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Edges = append(m.Edges, &LocalExecuteGraph_Edge{})
+			if err := m.Edges[len(m.Edges)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LocalExecuteGraph_Edge) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Edge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Edge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Source = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceFd", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SourceFd = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Destination = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationFd", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DestinationFd = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Record = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LocalExecuteGraphResult) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocalExecuteGraphResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocalExecuteGraphResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &LocalExecutionResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transcript", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transcript == nil {
+				m.Transcript = &Blob{}
+			}
+			if err := m.Transcript.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TranscriptTruncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.TranscriptTruncated = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LocalExecution) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocalExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocalExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Parameters == nil {
+				m.Parameters = &LocalExecutionParameters{}
+			}
+			if err := m.Parameters.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &LocalExecutionResult{}
+			}
+			if err := m.Result.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BinaryTypeRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BinaryTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BinaryTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pathname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Pathname = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BinaryTypeResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BinaryTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BinaryTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Failure = &b
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v BinaryTypeResponse_Win32BinaryType
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (BinaryTypeResponse_Win32BinaryType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClearSandboxRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClearSandboxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClearSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sandbox", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Sandbox = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Lease = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AcquireSandboxRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcquireSandboxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcquireSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Index = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMicros", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DurationMicros = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SandboxLease) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SandboxLease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SandboxLease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Index = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Token = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresUnixMicros", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExpiresUnixMicros = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenewLeaseRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenewLeaseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenewLeaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Token = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMicros", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DurationMicros = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseSandboxRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseSandboxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Token = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clear", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Clear = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Source = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Destination = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Lease = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func sovLocal(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozLocal(x uint64) (n int) {
-	return sovLocal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *LocalEnvironment) Unmarshal(data []byte) error {
+func (m *IdentifyRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2606,17 +9145,17 @@ func (m *LocalEnvironment) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LocalEnvironment: wiretype end group for non-group")
+			return fmt.Errorf("proto: IdentifyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LocalEnvironment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IdentifyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Empty", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContesterId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -2626,18 +9165,27 @@ func (m *LocalEnvironment) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Empty = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.ContesterId = &s
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Variable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MongoHost", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -2647,22 +9195,51 @@ func (m *LocalEnvironment) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLocal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Variable = append(m.Variable, &LocalEnvironment_Variable{})
-			if err := m.Variable[len(m.Variable)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			s := string(data[iNdEx:postIndex])
+			m.MongoHost = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MongoDb", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			s := string(data[iNdEx:postIndex])
+			m.MongoDb = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2686,8 +9263,7 @@ func (m *LocalEnvironment) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *LocalEnvironment_Variable) Unmarshal(data []byte) error {
-	var hasFields [1]uint64
+func (m *DiskUsage) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2710,15 +9286,106 @@ func (m *LocalEnvironment_Variable) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Variable: wiretype end group for non-group")
+			return fmt.Errorf("proto: DiskUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Variable: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DiskUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Quota = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Used = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SandboxLocations) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SandboxLocations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SandboxLocations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Compile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2744,14 +9411,43 @@ func (m *LocalEnvironment_Variable) Unmarshal(data []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(data[iNdEx:postIndex])
-			m.Name = &s
+			m.Compile = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Run", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Run = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompileDisk", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -2761,27 +9457,30 @@ func (m *LocalEnvironment_Variable) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLocal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(data[iNdEx:postIndex])
-			m.Value = &s
+			if m.CompileDisk == nil {
+				m.CompileDisk = &DiskUsage{}
+			}
+			if err := m.CompileDisk.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expand", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunDisk", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -2791,13 +9490,25 @@ func (m *LocalEnvironment_Variable) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Expand = &b
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RunDisk == nil {
+				m.RunDisk = &DiskUsage{}
+			}
+			if err := m.RunDisk.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLocal(data[iNdEx:])
@@ -2814,16 +9525,13 @@ func (m *LocalEnvironment_Variable) Unmarshal(data []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *LocalExecutionParameters) Unmarshal(data []byte) error {
+func (m *IdentifyResponse) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2846,15 +9554,15 @@ func (m *LocalExecutionParameters) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LocalExecutionParameters: wiretype end group for non-group")
+			return fmt.Errorf("proto: IdentifyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LocalExecutionParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IdentifyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvokerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2880,13 +9588,13 @@ func (m *LocalExecutionParameters) Unmarshal(data []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(data[iNdEx:postIndex])
-			m.ApplicationName = &s
+			m.InvokerId = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommandLine", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sandboxes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -2896,27 +9604,28 @@ func (m *LocalExecutionParameters) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLocal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(data[iNdEx:postIndex])
-			m.CommandLine = &s
+			m.Sandboxes = append(m.Sandboxes, &SandboxLocations{})
+			if err := m.Sandboxes[len(m.Sandboxes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentDirectory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -2926,27 +9635,30 @@ func (m *LocalExecutionParameters) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLocal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(data[iNdEx:postIndex])
-			m.CurrentDirectory = &s
+			if m.Environment == nil {
+				m.Environment = &LocalEnvironment{}
+			}
+			if err := m.Environment.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeLimitMicros", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
 			}
-			var v uint64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -2956,17 +9668,27 @@ func (m *LocalExecutionParameters) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TimeLimitMicros = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Platform = &s
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoryLimit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathSeparator", wireType)
 			}
-			var v uint64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -2976,17 +9698,27 @@ func (m *LocalExecutionParameters) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MemoryLimit = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.PathSeparator = &s
+			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckIdleness", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disks", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -2996,18 +9728,26 @@ func (m *LocalExecutionParameters) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.CheckIdleness = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disks = append(m.Disks, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramFiles", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -3017,51 +9757,45 @@ func (m *LocalExecutionParameters) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLocal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Environment == nil {
-				m.Environment = &LocalEnvironment{}
-			}
-			if err := m.Environment.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProgramFiles = append(m.ProgramFiles, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestrictUi", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpeedFactor", wireType)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLocal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			b := bool(v != 0)
-			m.RestrictUi = &b
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			v2 := float64(math.Float64frombits(v))
+			m.SpeedFactor = &v2
 		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoJob", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadCache", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -3071,38 +9805,30 @@ func (m *LocalExecutionParameters) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.NoJob = &b
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessLimit", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
 			}
-			var v uint32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLocal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				v |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ProcessLimit = &v
-		case 12:
+			if m.DownloadCache == nil {
+				m.DownloadCache = &DownloadCacheStats{}
+			}
+			if err := m.DownloadCache.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StdIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CalibrationError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -3112,30 +9838,78 @@ func (m *LocalExecutionParameters) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.CalibrationError = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthLocal
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DownloadCacheStats) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StdIn == nil {
-				m.StdIn = &RedirectParameters{}
-			}
-			if err := m.StdIn.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StdOut", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DownloadCacheStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DownloadCacheStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hits", wireType)
 			}
-			var msglen int
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -3145,30 +9919,17 @@ func (m *LocalExecutionParameters) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLocal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StdOut == nil {
-				m.StdOut = &RedirectParameters{}
-			}
-			if err := m.StdOut.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StdErr", wireType)
+			m.Hits = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misses", wireType)
 			}
-			var msglen int
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -3178,28 +9939,15 @@ func (m *LocalExecutionParameters) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLocal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StdErr == nil {
-				m.StdErr = &RedirectParameters{}
-			}
-			if err := m.StdErr.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 15:
+			m.Misses = &v
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeLimitHardMicros", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Evictions", wireType)
 			}
 			var v uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3216,12 +9964,12 @@ func (m *LocalExecutionParameters) Unmarshal(data []byte) error {
 					break
 				}
 			}
-			m.TimeLimitHardMicros = &v
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommandLineParameters", wireType)
+			m.Evictions = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -3231,26 +9979,17 @@ func (m *LocalExecutionParameters) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLocal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CommandLineParameters = append(m.CommandLineParameters, string(data[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SandboxId", wireType)
+			m.Entries = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedBytes", wireType)
 			}
-			var stringLen uint64
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -3260,27 +9999,17 @@ func (m *LocalExecutionParameters) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLocal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(data[iNdEx:postIndex])
-			m.SandboxId = &s
-			iNdEx = postIndex
-		case 18:
+			m.UsedBytes = &v
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinStdoutStderr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
 			}
-			var v int
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -3290,13 +10019,12 @@ func (m *LocalExecutionParameters) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.JoinStdoutStderr = &b
+			m.MaxBytes = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLocal(data[iNdEx:])
@@ -3319,7 +10047,7 @@ func (m *LocalExecutionParameters) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *LocalExecuteConnected) Unmarshal(data []byte) error {
+func (m *CalibrateRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3342,78 +10070,82 @@ func (m *LocalExecuteConnected) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LocalExecuteConnected: wiretype end group for non-group")
+			return fmt.Errorf("proto: CalibrateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LocalExecuteConnected: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CalibrateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLocal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthLocal
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.First == nil {
-				m.First = &LocalExecutionParameters{}
-			}
-			if err := m.First.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CalibrateResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Second", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLocal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLocal
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CalibrateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CalibrateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpeedFactor", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Second == nil {
-				m.Second = &LocalExecutionParameters{}
-			}
-			if err := m.Second.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			v2 := float64(math.Float64frombits(v))
+			m.SpeedFactor = &v2
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLocal(data[iNdEx:])
@@ -3436,7 +10168,7 @@ func (m *LocalExecuteConnected) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *LocalExecutionResult) Unmarshal(data []byte) error {
+func (m *FileStat) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3459,17 +10191,17 @@ func (m *LocalExecutionResult) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LocalExecutionResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileStat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LocalExecutionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileStat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -3479,30 +10211,27 @@ func (m *LocalExecutionResult) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLocal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flags == nil {
-				m.Flags = &ExecutionResultFlags{}
-			}
-			if err := m.Flags.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(data[iNdEx:postIndex])
+			m.Name = &s
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDirectory", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -3512,28 +10241,16 @@ func (m *LocalExecutionResult) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLocal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Time == nil {
-				m.Time = &ExecutionResultTime{}
-			}
-			if err := m.Time.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.IsDirectory = &b
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
 			var v uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3550,12 +10267,12 @@ func (m *LocalExecutionResult) Unmarshal(data []byte) error {
 					break
 				}
 			}
-			m.Memory = &v
+			m.Size_ = &v
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReturnCode", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
-			var v uint32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -3565,17 +10282,27 @@ func (m *LocalExecutionResult) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReturnCode = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Checksum = &s
+			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StdOut", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			var msglen int
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -3585,30 +10312,17 @@ func (m *LocalExecutionResult) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLocal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StdOut == nil {
-				m.StdOut = &Blob{}
-			}
-			if err := m.StdOut.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Mode = &v
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StdErr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MtimeMicros", wireType)
 			}
-			var msglen int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -3618,30 +10332,17 @@ func (m *LocalExecutionResult) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLocal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StdErr == nil {
-				m.StdErr = &Blob{}
-			}
-			if err := m.StdErr.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.MtimeMicros = &v
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalProcesses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			var v uint64
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -3651,17 +10352,17 @@ func (m *LocalExecutionResult) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (uint64(b) & 0x7F) << shift
+				v |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TotalProcesses = &v
+			m.Uid = &v
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KillSignal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gid", wireType)
 			}
-			var v int32
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -3671,17 +10372,17 @@ func (m *LocalExecutionResult) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (int32(b) & 0x7F) << shift
+				v |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.KillSignal = &v
+			m.Gid = &v
 		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StopSignal", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkTarget", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -3691,12 +10392,22 @@ func (m *LocalExecutionResult) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (int32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.StopSignal = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.SymlinkTarget = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLocal(data[iNdEx:])
@@ -3719,7 +10430,7 @@ func (m *LocalExecutionResult) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *LocalExecuteConnectedResult) Unmarshal(data []byte) error {
+func (m *StatRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3742,17 +10453,148 @@ func (m *LocalExecuteConnectedResult) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LocalExecuteConnectedResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LocalExecuteConnectedResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = append(m.Name, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SandboxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.SandboxId = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expand", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Expand = &b
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CalculateChecksum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.CalculateChecksum = &b
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Lease = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -3762,30 +10604,58 @@ func (m *LocalExecuteConnectedResult) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLocal
+			b := bool(v != 0)
+			m.Recursive = &b
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepth", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.First == nil {
-				m.First = &LocalExecutionResult{}
+			m.MaxDepth = &v
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
 			}
-			if err := m.First.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+			m.MaxEntries = &v
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Second", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumAlgorithm", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -3795,24 +10665,21 @@ func (m *LocalExecuteConnectedResult) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLocal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Second == nil {
-				m.Second = &LocalExecutionResult{}
-			}
-			if err := m.Second.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(data[iNdEx:postIndex])
+			m.ChecksumAlgorithm = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3836,8 +10703,7 @@ func (m *LocalExecuteConnectedResult) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *LocalExecution) Unmarshal(data []byte) error {
-	var hasFields [1]uint64
+func (m *FileStats) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3860,15 +10726,15 @@ func (m *LocalExecution) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LocalExecution: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LocalExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3892,17 +10758,35 @@ func (m *LocalExecution) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parameters == nil {
-				m.Parameters = &LocalExecutionParameters{}
-			}
-			if err := m.Parameters.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, &FileStat{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Truncated = &b
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CopyResults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3926,10 +10810,8 @@ func (m *LocalExecution) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &LocalExecutionResult{}
-			}
-			if err := m.Result.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.CopyResults = append(m.CopyResults, &CopyResult{})
+			if err := m.CopyResults[len(m.CopyResults)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3949,16 +10831,14 @@ func (m *LocalExecution) Unmarshal(data []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *BinaryTypeRequest) Unmarshal(data []byte) error {
+func (m *GetRequest) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3981,15 +10861,15 @@ func (m *BinaryTypeRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BinaryTypeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BinaryTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pathname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4015,85 +10895,14 @@ func (m *BinaryTypeRequest) Unmarshal(data []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(data[iNdEx:postIndex])
-			m.Pathname = &s
+			m.Name = &s
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLocal(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthLocal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BinaryTypeResponse) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLocal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BinaryTypeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BinaryTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLocal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Failure = &b
+			hasFields[0] |= uint64(0x00000001)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
 			}
-			var v BinaryTypeResponse_Win32BinaryType
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -4103,66 +10912,25 @@ func (m *BinaryTypeResponse) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (BinaryTypeResponse_Win32BinaryType(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Result = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLocal(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLocal
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClearSandboxRequest) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLocal
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClearSandboxRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClearSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			s := string(data[iNdEx:postIndex])
+			m.Lease = &s
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sandbox", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumAlgorithm", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4188,7 +10956,7 @@ func (m *ClearSandboxRequest) Unmarshal(data []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(data[iNdEx:postIndex])
-			m.Sandbox = &s
+			m.ChecksumAlgorithm = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4206,13 +10974,17 @@ func (m *ClearSandboxRequest) Unmarshal(data []byte) error {
 			iNdEx += skippy
 		}
 	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *IdentifyRequest) Unmarshal(data []byte) error {
+func (m *PutChunkRequest) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4235,15 +11007,15 @@ func (m *IdentifyRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IdentifyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutChunkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdentifyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutChunkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContesterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4269,13 +11041,14 @@ func (m *IdentifyRequest) Unmarshal(data []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(data[iNdEx:postIndex])
-			m.ContesterId = &s
+			m.Name = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MongoHost", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var stringLen uint64
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -4285,27 +11058,17 @@ func (m *IdentifyRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLocal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(data[iNdEx:postIndex])
-			m.MongoHost = &s
-			iNdEx = postIndex
+			m.Offset = &v
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MongoDb", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -4315,76 +11078,80 @@ func (m *IdentifyRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLocal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(data[iNdEx:postIndex])
-			m.MongoDb = &s
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLocal(data[iNdEx:])
-			if err != nil {
+			if m.Data == nil {
+				m.Data = &Blob{}
+			}
+			if err := m.Data.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLocal
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Final", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SandboxLocations) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLocal
+			b := bool(v != 0)
+			m.Final = &b
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha1", wireType)
 			}
-			if iNdEx >= l {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Sha1 = append(m.Sha1[:0], data[iNdEx:postIndex]...)
+			if m.Sha1 == nil {
+				m.Sha1 = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SandboxLocations: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SandboxLocations: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Compile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4410,11 +11177,11 @@ func (m *SandboxLocations) Unmarshal(data []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(data[iNdEx:postIndex])
-			m.Compile = &s
+			m.Lease = &s
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Run", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4440,7 +11207,7 @@ func (m *SandboxLocations) Unmarshal(data []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(data[iNdEx:postIndex])
-			m.Run = &s
+			m.Checksum = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4458,13 +11225,17 @@ func (m *SandboxLocations) Unmarshal(data []byte) error {
 			iNdEx += skippy
 		}
 	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *IdentifyResponse) Unmarshal(data []byte) error {
+func (m *GetChunkRequest) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4487,15 +11258,15 @@ func (m *IdentifyResponse) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IdentifyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetChunkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdentifyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetChunkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvokerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4521,13 +11292,14 @@ func (m *IdentifyResponse) Unmarshal(data []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(data[iNdEx:postIndex])
-			m.InvokerId = &s
+			m.Name = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sandboxes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var msglen int
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -4537,28 +11309,37 @@ func (m *IdentifyResponse) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLocal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Offset = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
-			m.Sandboxes = append(m.Sandboxes, &SandboxLocations{})
-			if err := m.Sandboxes[len(m.Sandboxes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+			m.Length = &v
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -4568,30 +11349,81 @@ func (m *IdentifyResponse) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLocal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Environment == nil {
-				m.Environment = &LocalEnvironment{}
-			}
-			if err := m.Environment.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			s := string(data[iNdEx:postIndex])
+			m.Lease = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
 			}
-			var stringLen uint64
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileChunk) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -4601,27 +11433,17 @@ func (m *IdentifyResponse) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLocal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(data[iNdEx:postIndex])
-			m.Platform = &s
-			iNdEx = postIndex
-		case 5:
+			m.Offset = &v
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathSeparator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -4631,27 +11453,30 @@ func (m *IdentifyResponse) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLocal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(data[iNdEx:postIndex])
-			m.PathSeparator = &s
+			if m.Data == nil {
+				m.Data = &Blob{}
+			}
+			if err := m.Data.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disks", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
 			}
-			var stringLen uint64
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -4661,26 +11486,38 @@ func (m *IdentifyResponse) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLocal
+			m.FileSize = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eof", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Disks = append(m.Disks, string(data[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 7:
+			b := bool(v != 0)
+			m.Eof = &b
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgramFiles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha1", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -4690,20 +11527,22 @@ func (m *IdentifyResponse) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthLocal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProgramFiles = append(m.ProgramFiles, string(data[iNdEx:postIndex]))
+			m.Sha1 = append(m.Sha1[:0], data[iNdEx:postIndex]...)
+			if m.Sha1 == nil {
+				m.Sha1 = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4727,7 +11566,8 @@ func (m *IdentifyResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *FileStat) Unmarshal(data []byte) error {
+func (m *PutArchiveRequest) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4750,15 +11590,15 @@ func (m *FileStat) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FileStat: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutArchiveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileStat: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutArchiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4784,13 +11624,14 @@ func (m *FileStat) Unmarshal(data []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(data[iNdEx:postIndex])
-			m.Name = &s
+			m.Destination = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsDirectory", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -4800,18 +11641,30 @@ func (m *FileStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.IsDirectory = &b
+			if msglen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &Blob{}
+			}
+			if err := m.Data.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			var v uint64
+			var v ArchiveFormat
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -4821,15 +11674,15 @@ func (m *FileStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (uint64(b) & 0x7F) << shift
+				v |= (ArchiveFormat(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Size_ = &v
+			m.Format = &v
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4855,7 +11708,7 @@ func (m *FileStat) Unmarshal(data []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(data[iNdEx:postIndex])
-			m.Checksum = &s
+			m.Lease = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4873,13 +11726,17 @@ func (m *FileStat) Unmarshal(data []byte) error {
 			iNdEx += skippy
 		}
 	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *StatRequest) Unmarshal(data []byte) error {
+func (m *GetArchiveRequest) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4902,10 +11759,10 @@ func (m *StatRequest) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetArchiveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetArchiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4935,13 +11792,15 @@ func (m *StatRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = append(m.Name, string(data[iNdEx:postIndex]))
+			s := string(data[iNdEx:postIndex])
+			m.Name = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SandboxId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expand", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -4951,27 +11810,18 @@ func (m *StatRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLocal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(data[iNdEx:postIndex])
-			m.SandboxId = &s
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.Expand = &b
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			var v int
+			var v ArchiveFormat
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -4981,18 +11831,17 @@ func (m *StatRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				v |= (ArchiveFormat(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Expand = &b
+			m.Format = &v
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CalculateChecksum", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -5002,13 +11851,22 @@ func (m *StatRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.CalculateChecksum = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Lease = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLocal(data[iNdEx:])
@@ -5025,13 +11883,16 @@ func (m *StatRequest) Unmarshal(data []byte) error {
 			iNdEx += skippy
 		}
 	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *FileStats) Unmarshal(data []byte) error {
+func (m *Archive) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5054,15 +11915,15 @@ func (m *FileStats) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FileStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: Archive: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Archive: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5086,68 +11947,18 @@ func (m *FileStats) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, &FileStat{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Data == nil {
+				m.Data = &Blob{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLocal(data[iNdEx:])
-			if err != nil {
+			if err := m.Data.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLocal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetRequest) Unmarshal(data []byte) error {
-	var hasFields [1]uint64
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLocal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			var stringLen uint64
+			var v ArchiveFormat
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLocal
@@ -5157,23 +11968,32 @@ func (m *GetRequest) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (ArchiveFormat(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLocal
+			m.Format = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			s := string(data[iNdEx:postIndex])
-			m.Name = &s
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
+			m.Files = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLocal(data[iNdEx:])
@@ -5190,9 +12010,6 @@ func (m *GetRequest) Unmarshal(data []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -5562,6 +12379,218 @@ func (m *CopyOperations) Unmarshal(data []byte) error {
 			s := string(data[iNdEx:postIndex])
 			m.SandboxId = &s
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Lease = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Parallelism = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.FailFast = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLocal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CopyResult) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CopyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CopyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v CopyResult_Status
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (CopyResult_Status(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Error = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Bytes = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMicros", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DurationMicros = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLocal(data[iNdEx:])
@@ -5794,6 +12823,36 @@ func (m *RepeatedNamePairEntries) Unmarshal(data []byte) error {
 			s := string(data[iNdEx:postIndex])
 			m.SandboxId = &s
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Lease = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLocal(data[iNdEx:])
@@ -5874,6 +12933,36 @@ func (m *RepeatedStringEntries) Unmarshal(data []byte) error {
 			}
 			m.Entries = append(m.Entries, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Lease = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLocal(data[iNdEx:])
@@ -6002,101 +13091,195 @@ var (
 )
 
 var fileDescriptorLocal = []byte{
-	// 1530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x57, 0x4b, 0x73, 0x1b, 0xc7,
-	0x11, 0x16, 0xf8, 0x04, 0x1a, 0xc4, 0x83, 0x23, 0x51, 0x5a, 0x29, 0x89, 0x42, 0xad, 0xa2, 0x44,
-	0x52, 0x12, 0xa4, 0x44, 0xa5, 0x54, 0xa9, 0xf8, 0x29, 0xbe, 0x2c, 0xd8, 0x94, 0xc8, 0x5a, 0xd0,
-	0x96, 0x7d, 0xda, 0x5a, 0x02, 0x43, 0x72, 0xc4, 0xc5, 0xce, 0x6a, 0x76, 0x97, 0x25, 0xea, 0x0f,
-	0xf8, 0xec, 0x9b, 0x1f, 0x07, 0x1f, 0x5c, 0xfe, 0x07, 0x3e, 0xfb, 0xec, 0xa3, 0x7f, 0x82, 0xcb,
-	0xae, 0xf2, 0xef, 0x70, 0xf7, 0xec, 0x0c, 0xb0, 0x00, 0x24, 0x93, 0xf4, 0x01, 0xc5, 0xed, 0x6f,
-	0xba, 0xa7, 0x7b, 0xfa, 0x4d, 0xa8, 0x6e, 0xc9, 0x6e, 0x10, 0xb6, 0x62, 0x25, 0x53, 0xc9, 0x1a,
-	0x5d, 0x19, 0xa5, 0x3c, 0x49, 0xb9, 0xca, 0x81, 0x6b, 0xd5, 0xd5, 0x50, 0xee, 0x25, 0x86, 0x68,
-	0x6c, 0xbc, 0xe0, 0xdd, 0x2c, 0x15, 0x32, 0xca, 0x01, 0xf7, 0xfb, 0x12, 0x34, 0xb5, 0xf8, 0x46,
-	0x74, 0x2c, 0x94, 0x8c, 0xfa, 0x3c, 0x4a, 0xd9, 0x25, 0x98, 0xe5, 0xfd, 0x38, 0x3d, 0x71, 0x4a,
-	0xcb, 0xa5, 0xdb, 0x65, 0x2f, 0x27, 0xd8, 0x26, 0x94, 0x8f, 0x03, 0x25, 0x82, 0xbd, 0x90, 0x3b,
-	0x53, 0xcb, 0xd3, 0xb7, 0xab, 0x2b, 0x77, 0x5b, 0x63, 0xca, 0x5a, 0xe3, 0x57, 0xb5, 0x3e, 0x32,
-	0x12, 0xde, 0x40, 0xf6, 0xda, 0x16, 0x94, 0x2d, 0xca, 0x18, 0xcc, 0x44, 0x41, 0x9f, 0xa3, 0xa2,
-	0xa9, 0xdb, 0x15, 0x4f, 0x7f, 0x93, 0xf6, 0xe3, 0x20, 0xcc, 0x48, 0x49, 0x09, 0xc1, 0x9c, 0x60,
-	0x97, 0x61, 0x8e, 0xbf, 0x88, 0x83, 0xa8, 0xe7, 0x4c, 0x6b, 0xa3, 0x0c, 0xe5, 0x7e, 0x3d, 0x07,
-	0x4e, 0xae, 0xd5, 0xbe, 0x6c, 0x27, 0x50, 0x78, 0x0d, 0xda, 0x94, 0xb0, 0x3b, 0xd0, 0x0c, 0xe2,
-	0x38, 0x14, 0xdd, 0x80, 0x0e, 0x7c, 0xa3, 0x8a, 0x6e, 0x6d, 0x14, 0xf0, 0x27, 0xa4, 0xf5, 0x06,
-	0x2c, 0x74, 0x65, 0xbf, 0x8f, 0x57, 0xfa, 0xa1, 0x88, 0xac, 0xf2, 0xaa, 0xc1, 0xb6, 0x10, 0x62,
-	0xff, 0x84, 0xc5, 0x6e, 0xa6, 0x14, 0x3e, 0xcb, 0xef, 0x09, 0xc5, 0xbb, 0xa9, 0x54, 0x27, 0xda,
-	0x9a, 0x8a, 0xd7, 0x34, 0x07, 0xeb, 0x16, 0x67, 0x77, 0x61, 0x31, 0x15, 0x7d, 0x8e, 0x97, 0xf5,
-	0x45, 0xea, 0xf7, 0x45, 0x57, 0xc9, 0xc4, 0x99, 0x41, 0xe6, 0x19, 0xaf, 0x41, 0x07, 0x5b, 0x84,
-	0x3f, 0xd6, 0x30, 0xe9, 0xee, 0xf3, 0x3e, 0x4a, 0xe5, 0xdc, 0xce, 0xac, 0x66, 0xab, 0xe6, 0x98,
-	0x66, 0x64, 0xb7, 0xa0, 0xde, 0x3d, 0xe4, 0xdd, 0x23, 0x5f, 0xf4, 0x42, 0x1e, 0xf1, 0x24, 0x71,
-	0xe6, 0xb4, 0x1b, 0x6a, 0x1a, 0x6d, 0x1b, 0x90, 0xad, 0x41, 0x95, 0x0f, 0xbd, 0xef, 0xcc, 0x23,
-	0x4f, 0x75, 0xe5, 0xc6, 0xa9, 0x61, 0xf2, 0x8a, 0x52, 0xec, 0xaf, 0x50, 0x55, 0xc8, 0xad, 0x44,
-	0x37, 0xf5, 0x33, 0xe1, 0x94, 0xb5, 0x22, 0xb0, 0xd0, 0x87, 0x82, 0x2d, 0xc1, 0x5c, 0x24, 0xfd,
-	0x67, 0x72, 0xcf, 0xa9, 0xe4, 0x09, 0x12, 0xc9, 0xf7, 0xe5, 0x1e, 0xbb, 0x09, 0x35, 0xbc, 0xbe,
-	0x8b, 0x76, 0x98, 0x77, 0x00, 0x9e, 0xd6, 0xbc, 0x05, 0x03, 0xe6, 0x0f, 0xf9, 0x3f, 0xcc, 0x25,
-	0x69, 0xcf, 0x17, 0x91, 0xb3, 0xa0, 0x8d, 0xbb, 0x39, 0x61, 0x9c, 0xc7, 0x73, 0xef, 0x0e, 0xe3,
-	0xe8, 0xcd, 0xa2, 0x48, 0x3b, 0x62, 0x6f, 0xc2, 0x3c, 0xc9, 0xca, 0x2c, 0x75, 0x6a, 0x67, 0x17,
-	0x26, 0x7d, 0xdb, 0x59, 0x6a, 0xa5, 0xb9, 0x52, 0x4e, 0xfd, 0x7c, 0xd2, 0x1b, 0x4a, 0xb1, 0xfb,
-	0x70, 0xb9, 0x10, 0xcf, 0xc3, 0x40, 0xf5, 0x6c, 0x50, 0x1b, 0x3a, 0x5a, 0x17, 0x07, 0x41, 0x7d,
-	0x84, 0x67, 0x26, 0xb0, 0x0f, 0xe0, 0x4a, 0x31, 0xa9, 0xfc, 0x78, 0x70, 0xaf, 0xd3, 0xc4, 0x0a,
-	0xaa, 0x78, 0x4b, 0x85, 0xfc, 0x2a, 0xe4, 0xed, 0x5f, 0x00, 0x12, 0x44, 0xf7, 0xe4, 0x0b, 0x8c,
-	0xb7, 0xb3, 0xa8, 0x53, 0xac, 0x62, 0x90, 0x76, 0x8f, 0xfd, 0x0b, 0xd8, 0x33, 0x29, 0x22, 0x1f,
-	0x4d, 0x43, 0x5f, 0xd0, 0x1f, 0x7a, 0x14, 0xd3, 0xb1, 0x68, 0xd2, 0x49, 0x47, 0x1f, 0x74, 0x34,
-	0xee, 0x7e, 0x55, 0x82, 0xa5, 0x42, 0x85, 0xf0, 0x35, 0x19, 0x45, 0xf8, 0x46, 0xde, 0x63, 0xef,
-	0xc0, 0xec, 0xbe, 0x50, 0x49, 0xaa, 0x6b, 0xa2, 0xba, 0x72, 0xe7, 0x35, 0x79, 0x32, 0x59, 0x58,
-	0x5e, 0x2e, 0xc7, 0x1e, 0x62, 0x30, 0x39, 0x0a, 0xf5, 0x74, 0xb9, 0x9c, 0xeb, 0x06, 0x23, 0xe8,
-	0x7e, 0x36, 0x0d, 0x97, 0x46, 0x99, 0x3c, 0x9e, 0x64, 0x61, 0xca, 0xde, 0x40, 0xe3, 0xc2, 0xe0,
-	0x20, 0x31, 0xc6, 0xdd, 0x9a, 0xb8, 0x7a, 0x4c, 0x60, 0x93, 0x98, 0xbd, 0x5c, 0x86, 0xfd, 0x0f,
-	0x66, 0x28, 0x1e, 0xc6, 0xac, 0xbf, 0x9d, 0x26, 0xbb, 0x8b, 0xbc, 0x9e, 0x96, 0xa0, 0x3e, 0x93,
-	0xd7, 0x9d, 0xae, 0xec, 0x19, 0xcf, 0x50, 0x79, 0x51, 0xa4, 0x99, 0x8a, 0xfc, 0xae, 0xec, 0x71,
-	0x5d, 0xc9, 0x35, 0x2a, 0x0a, 0x82, 0xd6, 0x10, 0x61, 0xad, 0x61, 0x72, 0xce, 0x6a, 0xad, 0x4b,
-	0x13, 0x5a, 0xa9, 0x13, 0x0f, 0xd2, 0xb1, 0x35, 0x4c, 0xc7, 0xb9, 0xd3, 0xf8, 0x29, 0x01, 0xff,
-	0x01, 0x8d, 0x54, 0xa6, 0x41, 0xe8, 0x9b, 0x72, 0xe2, 0x89, 0x2e, 0xef, 0x19, 0xaf, 0xae, 0xe1,
-	0x1d, 0x8b, 0x92, 0xa5, 0x47, 0x22, 0x0c, 0xfd, 0x44, 0x1c, 0x44, 0x41, 0xa8, 0xcb, 0x77, 0xd6,
-	0x03, 0x82, 0x3a, 0x1a, 0x21, 0x86, 0x24, 0x95, 0xb1, 0x65, 0xa8, 0xe4, 0x0c, 0x04, 0xe5, 0x0c,
-	0xee, 0x17, 0x25, 0xf8, 0xd3, 0x2b, 0x33, 0xa6, 0x10, 0x9a, 0x42, 0xde, 0xdc, 0x3a, 0x25, 0xea,
-	0xb9, 0x94, 0xcd, 0x99, 0xb7, 0xc6, 0x72, 0xe6, 0x8c, 0xd2, 0x36, 0x5f, 0xbe, 0x2c, 0x41, 0x7d,
-	0x94, 0x81, 0xb5, 0x01, 0x0a, 0x85, 0x45, 0xa3, 0xe4, 0x5c, 0x99, 0x58, 0x10, 0x26, 0xe3, 0x94,
-	0xd6, 0x77, 0x4e, 0xe3, 0x72, 0x21, 0xf7, 0x3f, 0xb0, 0xb8, 0x2a, 0xa2, 0x40, 0x9d, 0xec, 0x9e,
-	0xc4, 0xdc, 0xe3, 0xcf, 0x33, 0x94, 0x64, 0xd7, 0xa0, 0x1c, 0x07, 0xe9, 0x61, 0x61, 0xf8, 0x0c,
-	0x68, 0xf7, 0x9b, 0x29, 0x60, 0x45, 0x89, 0x24, 0x96, 0x51, 0xc2, 0x99, 0x03, 0xf3, 0xfb, 0x81,
-	0x08, 0x33, 0xc5, 0xcd, 0x08, 0xb6, 0x24, 0xfb, 0x60, 0xc4, 0xc0, 0xfa, 0xca, 0xfd, 0xc9, 0xa4,
-	0x99, 0xb8, 0xae, 0xf5, 0x54, 0x44, 0xf7, 0x57, 0x0a, 0xb8, 0x35, 0xf7, 0xdb, 0x12, 0x34, 0xc6,
-	0xce, 0x70, 0xfa, 0x36, 0x3b, 0x6b, 0x1d, 0x1f, 0xb1, 0xf6, 0xae, 0xbf, 0xda, 0x7e, 0xf2, 0xd0,
-	0xfb, 0xa4, 0x79, 0x01, 0xe7, 0x74, 0x9d, 0xd0, 0xf5, 0xed, 0x8e, 0xc5, 0x4a, 0x16, 0x7b, 0xba,
-	0xfd, 0xd4, 0x62, 0x53, 0x16, 0xdb, 0x69, 0x6f, 0x5a, 0x6c, 0xda, 0xde, 0xb8, 0xb3, 0xdd, 0x69,
-	0x7f, 0x6c, 0xd1, 0x19, 0x8b, 0x6e, 0x77, 0x56, 0xee, 0x3d, 0xb0, 0xe8, 0xac, 0x45, 0x1f, 0xfc,
-	0xb7, 0xa0, 0x7d, 0x0e, 0xdd, 0x7a, 0x71, 0x2d, 0xe4, 0x81, 0xea, 0xe4, 0x1d, 0xd0, 0x3a, 0x16,
-	0xbd, 0x64, 0x7a, 0xa2, 0xf1, 0xab, 0x25, 0xdd, 0x08, 0x1a, 0xed, 0x1e, 0x8e, 0x32, 0xb1, 0x7f,
-	0x62, 0x99, 0xf5, 0x7c, 0x37, 0x9e, 0xa2, 0xa6, 0x5a, 0xb2, 0xf3, 0xdd, 0x60, 0xd8, 0x56, 0xb1,
-	0xeb, 0xf6, 0x65, 0x74, 0x20, 0xfd, 0x43, 0x99, 0xa4, 0x66, 0x01, 0xa8, 0x68, 0xe4, 0x11, 0x02,
-	0xec, 0x2a, 0x94, 0xf3, 0xe3, 0xde, 0x9e, 0x99, 0xfa, 0xf3, 0x9a, 0x5e, 0xdf, 0x73, 0xdf, 0x46,
-	0xb3, 0x73, 0xd5, 0x94, 0x1e, 0x94, 0x18, 0x09, 0x59, 0x87, 0xcd, 0x3d, 0x16, 0xa1, 0x8d, 0xba,
-	0x25, 0x59, 0x13, 0xa6, 0x55, 0x16, 0x19, 0x05, 0xf4, 0xe9, 0x7e, 0x37, 0x05, 0xcd, 0xa1, 0xc1,
-	0x26, 0x09, 0xd0, 0x1c, 0x11, 0x1d, 0xcb, 0xa3, 0xa2, 0xbd, 0x15, 0x83, 0xb4, 0xa9, 0x79, 0xdb,
-	0x89, 0x80, 0x9d, 0x20, 0xdf, 0xc7, 0x26, 0x07, 0xfd, 0xb8, 0x55, 0xde, 0x50, 0x66, 0x7c, 0x57,
-	0x98, 0xfe, 0x43, 0xbb, 0x02, 0x25, 0x77, 0x18, 0xa4, 0xfb, 0x52, 0xf5, 0x75, 0x4f, 0xa4, 0xe4,
-	0x36, 0x34, 0xed, 0x2c, 0x94, 0xe8, 0x7e, 0xc2, 0xa9, 0xc2, 0x70, 0x2b, 0xd2, 0x8d, 0xb1, 0xe2,
-	0xd5, 0x08, 0xed, 0x58, 0x90, 0xf6, 0xbd, 0x9e, 0x48, 0x8e, 0x68, 0xa3, 0xa1, 0x91, 0x98, 0x13,
-	0xcc, 0x05, 0xda, 0x1b, 0x0e, 0xb0, 0x32, 0x37, 0xd1, 0x67, 0xd4, 0xeb, 0xe8, 0x70, 0x04, 0x73,
-	0x9f, 0x43, 0x99, 0x3e, 0x3a, 0x69, 0x90, 0x16, 0x36, 0xc9, 0xd2, 0x60, 0x93, 0xc4, 0x98, 0x8b,
-	0xa4, 0xb0, 0xab, 0x4d, 0xe9, 0x5a, 0xaa, 0x8a, 0x64, 0xb8, 0xa6, 0xa1, 0x58, 0x22, 0x5e, 0x72,
-	0xd3, 0xec, 0xf5, 0x37, 0xbd, 0x49, 0x6f, 0x55, 0x49, 0x36, 0x78, 0x93, 0xa5, 0xdd, 0x4f, 0x4b,
-	0x50, 0x25, 0x7d, 0x36, 0xad, 0x86, 0x6a, 0xa7, 0x07, 0x6a, 0x47, 0xa7, 0xf7, 0xd4, 0xf8, 0xf4,
-	0x7e, 0xcd, 0x26, 0xcb, 0xfe, 0x0d, 0x0c, 0x3d, 0xdd, 0xcd, 0xd0, 0x7d, 0xdc, 0x1f, 0x31, 0xa0,
-	0xec, 0x2d, 0x0e, 0x4e, 0xd6, 0xac, 0x25, 0xef, 0x42, 0xc5, 0x3e, 0x3e, 0xc1, 0xed, 0x64, 0x1e,
-	0xa3, 0xa1, 0x04, 0x4f, 0xb4, 0x25, 0xd5, 0x95, 0xab, 0x13, 0x71, 0xb4, 0xcc, 0x9e, 0xe5, 0x74,
-	0x97, 0x01, 0xde, 0xe3, 0xaf, 0x78, 0xc9, 0x60, 0x15, 0x77, 0xeb, 0xb0, 0xb0, 0x41, 0xbb, 0xff,
-	0x63, 0x9c, 0x2c, 0xc1, 0x01, 0x77, 0x7f, 0x2d, 0x41, 0x6d, 0x4d, 0xc6, 0x27, 0xdb, 0x31, 0x57,
-	0x3a, 0xa1, 0xd8, 0xdf, 0xa1, 0x11, 0x52, 0x82, 0xf8, 0xfb, 0x78, 0x7d, 0x71, 0xc1, 0xae, 0x69,
-	0x98, 0x94, 0xea, 0xf5, 0x1a, 0xa7, 0x97, 0xc2, 0x41, 0x8a, 0x2f, 0x0b, 0x4d, 0x2e, 0x1a, 0xc7,
-	0xd4, 0x73, 0xd8, 0x66, 0x28, 0x79, 0x27, 0x8b, 0x43, 0x19, 0x0c, 0xbc, 0x93, 0x53, 0xbf, 0x17,
-	0x14, 0x1a, 0x68, 0x7d, 0xd9, 0xcb, 0xd0, 0x80, 0x14, 0x5b, 0x98, 0xc9, 0x32, 0xc8, 0x21, 0xdd,
-	0xd4, 0xb0, 0x81, 0x04, 0x59, 0x7a, 0x28, 0x95, 0x78, 0x99, 0xff, 0x27, 0x90, 0x62, 0x11, 0x45,
-	0x7a, 0xee, 0x56, 0x3c, 0x36, 0x72, 0xb4, 0x4b, 0x27, 0xae, 0x80, 0xfa, 0xc8, 0x3b, 0x69, 0xa3,
-	0x18, 0xf3, 0xf0, 0xf5, 0x09, 0x0f, 0x8f, 0x48, 0x0c, 0xdc, 0x7c, 0x4a, 0x3a, 0xb8, 0xeb, 0x50,
-	0x26, 0x0f, 0xed, 0x04, 0x42, 0xd1, 0xe3, 0x13, 0x99, 0xa9, 0xae, 0x8d, 0x82, 0xa1, 0xd8, 0x32,
-	0x54, 0x7b, 0xa8, 0x09, 0xbb, 0xb4, 0xf1, 0x1c, 0x1d, 0x16, 0x21, 0xb7, 0x0f, 0x57, 0x3c, 0x1e,
-	0x73, 0x4c, 0x90, 0x9e, 0xbd, 0x6d, 0xc3, 0xe8, 0x3f, 0x43, 0x6e, 0x58, 0x91, 0x33, 0x1b, 0x7d,
-	0x0f, 0x96, 0xac, 0xba, 0x0e, 0x0a, 0x44, 0x07, 0x56, 0x99, 0x33, 0xaa, 0xac, 0x32, 0xb8, 0x71,
-	0xb5, 0xf5, 0xc3, 0xcf, 0xd7, 0x4b, 0x3f, 0xe2, 0xef, 0x27, 0xfc, 0x7d, 0xfe, 0xcb, 0xf5, 0x0b,
-	0xf0, 0x67, 0xa9, 0x0e, 0x5a, 0xf4, 0x04, 0xac, 0xe8, 0x93, 0x71, 0x9b, 0x7e, 0x0b, 0x00, 0x00,
-	0xff, 0xff, 0xd1, 0x5c, 0x4d, 0x6e, 0xd6, 0x0e, 0x00, 0x00,
+	// 3027 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcd, 0x73, 0x1b, 0xc7,
+	0x95, 0xd7, 0xe0, 0x83, 0x04, 0x1e, 0x08, 0x12, 0x6c, 0x91, 0x12, 0x2c, 0xd9, 0x5a, 0x6a, 0xbc,
+	0x5e, 0x51, 0xda, 0x5d, 0xba, 0x44, 0xad, 0x55, 0x5b, 0xbb, 0xeb, 0x75, 0xf1, 0x53, 0xa6, 0x2d,
+	0x99, 0xf4, 0x80, 0xb6, 0xd6, 0x9b, 0xc3, 0x54, 0x73, 0xa6, 0x09, 0xb4, 0x39, 0x98, 0x81, 0xbb,
+	0x7b, 0x64, 0xd2, 0xc7, 0xf8, 0x90, 0x53, 0x0e, 0xb9, 0xa5, 0x52, 0xa9, 0x5c, 0x52, 0xae, 0xca,
+	0x29, 0xa7, 0x9c, 0x72, 0xc8, 0xc9, 0x07, 0x1f, 0x72, 0x70, 0xe5, 0x2f, 0x48, 0x39, 0x55, 0xf9,
+	0x07, 0xf2, 0x0f, 0xa4, 0x5e, 0x7f, 0x00, 0x03, 0x80, 0x94, 0x44, 0x3b, 0x27, 0xcc, 0xfb, 0x75,
+	0xbf, 0xfe, 0x78, 0xfd, 0xde, 0xaf, 0x5f, 0x3f, 0x40, 0xe3, 0x71, 0x16, 0xd1, 0x64, 0x6d, 0x20,
+	0x32, 0x95, 0x91, 0x85, 0x28, 0x4b, 0x15, 0x93, 0x8a, 0x09, 0x03, 0xdc, 0x68, 0x6c, 0x26, 0xd9,
+	0x91, 0xb4, 0xc2, 0xc2, 0xce, 0x29, 0x8b, 0x72, 0xc5, 0xb3, 0xd4, 0x00, 0xfe, 0x1f, 0x3c, 0x68,
+	0x69, 0xf5, 0x9d, 0xf4, 0x19, 0x17, 0x59, 0xda, 0x67, 0xa9, 0x22, 0x4b, 0x50, 0x65, 0xfd, 0x81,
+	0x3a, 0x6b, 0x7b, 0x2b, 0xde, 0x6a, 0x2d, 0x30, 0x02, 0xd9, 0x85, 0xda, 0x33, 0x2a, 0x38, 0x3d,
+	0x4a, 0x58, 0xbb, 0xb4, 0x52, 0x5e, 0x6d, 0xac, 0xdf, 0x5b, 0x9b, 0x98, 0x6c, 0x6d, 0x72, 0xa8,
+	0xb5, 0x8f, 0xad, 0x46, 0x30, 0xd4, 0xbd, 0xf1, 0x18, 0x6a, 0x0e, 0x25, 0x04, 0x2a, 0x29, 0xed,
+	0xb3, 0xb6, 0xb7, 0x52, 0x5a, 0xad, 0x07, 0xfa, 0x1b, 0x67, 0x7f, 0x46, 0x93, 0x1c, 0x27, 0xf1,
+	0x56, 0xeb, 0x81, 0x11, 0xc8, 0x35, 0x98, 0x61, 0xa7, 0x03, 0x9a, 0xc6, 0xed, 0xb2, 0x5e, 0x94,
+	0x95, 0xfc, 0xaf, 0x6a, 0xd0, 0x36, 0xb3, 0xba, 0x9d, 0x1d, 0x50, 0x41, 0xfb, 0x4c, 0x31, 0x21,
+	0xc9, 0x5d, 0x68, 0xd1, 0xc1, 0x20, 0xe1, 0x11, 0xc5, 0x86, 0xd0, 0x4e, 0x85, 0xa3, 0x2e, 0x14,
+	0xf0, 0x0f, 0x70, 0xd6, 0xdb, 0x30, 0x17, 0x65, 0xfd, 0x3e, 0x4d, 0xe3, 0x30, 0xe1, 0xa9, 0x9b,
+	0xbc, 0x61, 0xb1, 0xc7, 0x3c, 0x65, 0xe4, 0x5f, 0x61, 0x31, 0xca, 0x85, 0x60, 0xa9, 0x0a, 0x63,
+	0x2e, 0x58, 0xa4, 0x32, 0x71, 0xa6, 0x57, 0x53, 0x0f, 0x5a, 0xb6, 0x61, 0xdb, 0xe1, 0xe4, 0x1e,
+	0x2c, 0x2a, 0xde, 0x67, 0x61, 0xc2, 0xfb, 0x5c, 0x85, 0x7d, 0x1e, 0x89, 0x4c, 0xb6, 0x2b, 0x2b,
+	0xde, 0x6a, 0x25, 0x58, 0xc0, 0x86, 0xc7, 0x88, 0x3f, 0xd1, 0x30, 0xce, 0xdd, 0x67, 0xfd, 0x4c,
+	0x9c, 0x99, 0xde, 0xed, 0xaa, 0xee, 0xd6, 0x30, 0x98, 0xee, 0x48, 0xde, 0x80, 0xf9, 0xa8, 0xc7,
+	0xa2, 0x93, 0x90, 0xc7, 0x09, 0x4b, 0x99, 0x94, 0xed, 0x19, 0x6d, 0x86, 0xa6, 0x46, 0xf7, 0x2c,
+	0x48, 0xb6, 0xa0, 0xc1, 0x46, 0xd6, 0x6f, 0xcf, 0xae, 0x78, 0xab, 0x8d, 0xf5, 0xdb, 0x2f, 0x3c,
+	0xa6, 0xa0, 0xa8, 0x45, 0xfe, 0x09, 0x1a, 0x82, 0x49, 0x25, 0x78, 0xa4, 0xc2, 0x9c, 0xb7, 0x6b,
+	0x7a, 0x22, 0x70, 0xd0, 0x47, 0x9c, 0x2c, 0xc3, 0x4c, 0x9a, 0x85, 0x9f, 0x66, 0x47, 0xed, 0xba,
+	0x71, 0x90, 0x34, 0x7b, 0x2f, 0x3b, 0x22, 0xaf, 0x43, 0x73, 0x20, 0xb2, 0x88, 0x49, 0x69, 0xf7,
+	0x01, 0x2b, 0xde, 0x6a, 0x33, 0x98, 0xb3, 0xa0, 0xd9, 0xc8, 0x7f, 0xc1, 0x8c, 0x54, 0x71, 0xc8,
+	0xd3, 0xf6, 0x9c, 0x5e, 0xdc, 0xeb, 0x53, 0x8b, 0x0b, 0x98, 0xb1, 0xee, 0xe8, 0x1c, 0x83, 0xaa,
+	0x54, 0xf1, 0x5e, 0x4a, 0xfe, 0x07, 0x66, 0x51, 0x37, 0xcb, 0x55, 0xbb, 0xf9, 0xf2, 0xca, 0x38,
+	0xdf, 0x7e, 0xae, 0x9c, 0x36, 0x13, 0xa2, 0x3d, 0x7f, 0x39, 0xed, 0x1d, 0x21, 0xc8, 0x03, 0xb8,
+	0x56, 0x38, 0xcf, 0x1e, 0x15, 0xb1, 0x3b, 0xd4, 0x05, 0x7d, 0x5a, 0x57, 0x87, 0x87, 0xfa, 0x2e,
+	0x15, 0xb1, 0x3d, 0xd8, 0x87, 0x70, 0xbd, 0xe8, 0x54, 0xe1, 0x60, 0x38, 0x6e, 0xbb, 0xb5, 0x52,
+	0x5e, 0xad, 0x07, 0xcb, 0x05, 0xff, 0x2a, 0xf8, 0xed, 0x6b, 0x00, 0x92, 0xa6, 0xf1, 0x51, 0x76,
+	0x1a, 0xf2, 0xb8, 0xbd, 0xa8, 0x5d, 0xac, 0x6e, 0x91, 0xbd, 0x98, 0xfc, 0x1b, 0x90, 0x4f, 0x33,
+	0x9e, 0x86, 0x52, 0xc5, 0x59, 0xae, 0xf0, 0x07, 0x37, 0x45, 0xf4, 0x59, 0xb4, 0xb0, 0xa5, 0xa3,
+	0x1b, 0x3a, 0x1a, 0xc7, 0xc8, 0x11, 0x6c, 0xc0, 0xa8, 0x6a, 0x5f, 0xd5, 0xe7, 0x61, 0x25, 0xf2,
+	0x23, 0x68, 0x9a, 0xaf, 0x70, 0x90, 0x25, 0x3c, 0x3a, 0x6b, 0x2f, 0xad, 0x78, 0xab, 0xf3, 0xeb,
+	0x0f, 0x2f, 0xf0, 0x96, 0xe9, 0xf0, 0x5a, 0x0b, 0xb4, 0xfa, 0x81, 0xd6, 0x0e, 0xe6, 0x44, 0x41,
+	0x42, 0x7f, 0x4d, 0x33, 0xd1, 0xa7, 0x09, 0xff, 0x82, 0x85, 0x68, 0x9a, 0xf6, 0xb2, 0xf1, 0xd7,
+	0x21, 0x7a, 0xc8, 0xfb, 0x0c, 0x5d, 0x4d, 0x32, 0x2a, 0xa2, 0x5e, 0x38, 0xa0, 0xaa, 0xd7, 0xbe,
+	0x66, 0x5c, 0xcd, 0x40, 0x07, 0x54, 0xf5, 0x90, 0x0c, 0x12, 0x46, 0x25, 0x6b, 0x5f, 0x37, 0x64,
+	0xa0, 0x05, 0xff, 0x3e, 0xcc, 0x15, 0xe7, 0x26, 0x75, 0xa8, 0x3e, 0xdd, 0x0f, 0x3a, 0x87, 0xad,
+	0x2b, 0xa4, 0x06, 0x95, 0xcd, 0x9d, 0xce, 0x61, 0xcb, 0x23, 0x73, 0x50, 0x7b, 0xb2, 0xf1, 0xde,
+	0x7e, 0xb0, 0x77, 0xf8, 0x49, 0xab, 0xe4, 0x7f, 0xe3, 0xc1, 0x72, 0x61, 0x23, 0x6c, 0x2b, 0x4b,
+	0x53, 0x16, 0x29, 0x16, 0x93, 0x77, 0xa0, 0x7a, 0xcc, 0x85, 0x54, 0x9a, 0x19, 0x1a, 0xeb, 0x77,
+	0x5f, 0x7a, 0xff, 0x81, 0xd1, 0x23, 0x1b, 0x30, 0x23, 0x59, 0x94, 0xa5, 0x71, 0xbb, 0x74, 0xd9,
+	0x11, 0xac, 0x22, 0x52, 0x8b, 0x60, 0x51, 0x26, 0xe2, 0x50, 0x09, 0x9a, 0xca, 0x48, 0xf0, 0x81,
+	0xb2, 0x44, 0xd7, 0x32, 0x0d, 0x87, 0x43, 0xdc, 0xff, 0x63, 0x15, 0x96, 0xc6, 0x47, 0x0c, 0x98,
+	0xcc, 0x13, 0x45, 0xfe, 0x1b, 0xaa, 0xc7, 0x09, 0xed, 0x4a, 0xbb, 0x93, 0x37, 0xa6, 0xd6, 0x31,
+	0xa1, 0xb0, 0x8b, 0x9d, 0x03, 0xa3, 0x43, 0xfe, 0x13, 0x2a, 0xfa, 0x9c, 0xcc, 0x1e, 0xfe, 0xf9,
+	0x45, 0xba, 0x78, 0x7c, 0x81, 0xd6, 0x40, 0x07, 0x33, 0x54, 0xa5, 0x57, 0x5c, 0x09, 0xac, 0x64,
+	0x78, 0x44, 0xe5, 0x22, 0x0d, 0xa3, 0x2c, 0x66, 0x9a, 0xfc, 0x9a, 0x01, 0x18, 0x68, 0x2b, 0x8b,
+	0x19, 0x59, 0x1b, 0xc5, 0x73, 0x55, 0xcf, 0xba, 0x3c, 0x35, 0x2b, 0x5e, 0x5e, 0xc3, 0x08, 0x5e,
+	0x1b, 0x45, 0xf0, 0xcc, 0x8b, 0xfa, 0x63, 0xcc, 0xde, 0x81, 0x05, 0x95, 0x29, 0x9a, 0x84, 0x96,
+	0x81, 0x98, 0xd4, 0x8c, 0x58, 0x09, 0xe6, 0x35, 0x7c, 0xe0, 0x50, 0x5c, 0xe9, 0x09, 0x4f, 0x92,
+	0x50, 0xf2, 0x6e, 0x4a, 0x13, 0xcd, 0x78, 0xd5, 0x00, 0x10, 0xea, 0x68, 0x04, 0x3b, 0x48, 0x95,
+	0x0d, 0x5c, 0x87, 0xba, 0xe9, 0x80, 0x90, 0xed, 0x70, 0x0f, 0x16, 0xed, 0x56, 0x42, 0x25, 0xf2,
+	0x34, 0xa2, 0x8a, 0xc5, 0x9a, 0xff, 0x6a, 0xc1, 0x82, 0x59, 0xfd, 0xa1, 0x83, 0x5d, 0x5f, 0x26,
+	0x44, 0xa1, 0x6f, 0x63, 0xd8, 0x77, 0x47, 0x88, 0x51, 0xdf, 0x0f, 0xd0, 0x31, 0x74, 0x90, 0x4a,
+	0x45, 0x15, 0x97, 0x8a, 0x47, 0xb2, 0x3d, 0x77, 0x01, 0xad, 0x9b, 0x98, 0xe8, 0x0c, 0x3b, 0xa2,
+	0xef, 0x8c, 0x23, 0xe4, 0x09, 0x2c, 0x0c, 0x23, 0x30, 0x36, 0x81, 0xd9, 0xbc, 0xc4, 0x81, 0x8f,
+	0x82, 0x3a, 0x46, 0x99, 0x6c, 0x42, 0x53, 0x32, 0x95, 0x0f, 0xc2, 0x63, 0xca, 0x93, 0x5c, 0x30,
+	0xcb, 0xac, 0xaf, 0x4d, 0x0d, 0xd6, 0xc1, 0x5e, 0xbb, 0xa6, 0x53, 0x30, 0x27, 0x0b, 0x92, 0xff,
+	0x13, 0x0f, 0xe6, 0x8a, 0xcd, 0x18, 0xf3, 0x52, 0xd1, 0xae, 0xbb, 0xaa, 0x8d, 0x80, 0x28, 0x13,
+	0x22, 0xcd, 0xb4, 0x83, 0x36, 0x03, 0x23, 0x60, 0x02, 0xa1, 0x99, 0xc3, 0x5c, 0xc3, 0xfa, 0x1b,
+	0xd9, 0x33, 0x97, 0x4c, 0xa0, 0x81, 0x33, 0xa1, 0xdd, 0xae, 0x16, 0xd4, 0x11, 0xd9, 0x41, 0x80,
+	0xb4, 0x61, 0xb6, 0xcf, 0xa4, 0xc4, 0x09, 0xaa, 0x5a, 0xcb, 0x89, 0xfe, 0x9f, 0x4a, 0xd0, 0x9a,
+	0xb4, 0x21, 0xce, 0x20, 0xf2, 0xd4, 0xc4, 0x54, 0x33, 0xd0, 0xdf, 0xe4, 0x43, 0x68, 0xe9, 0x19,
+	0xf4, 0x8d, 0x60, 0xaf, 0x01, 0x13, 0x37, 0x77, 0x5e, 0x78, 0x28, 0x6b, 0x01, 0x4d, 0xbb, 0x2c,
+	0x98, 0xc7, 0x01, 0xd0, 0x86, 0xf6, 0xaa, 0xf8, 0x10, 0x5a, 0x9f, 0xd3, 0x24, 0x19, 0x1b, 0xb2,
+	0x7c, 0xc9, 0x21, 0x71, 0x80, 0xc2, 0x90, 0xef, 0x0c, 0xe3, 0xb2, 0x72, 0xb9, 0x81, 0xac, 0xda,
+	0x8d, 0x2d, 0xa8, 0x6a, 0x80, 0xb4, 0xa0, 0xdc, 0xe7, 0xa9, 0x36, 0x41, 0x25, 0xc0, 0x4f, 0x13,
+	0xf3, 0x31, 0xa7, 0x69, 0xbb, 0xe4, 0x62, 0x1e, 0x25, 0xdd, 0x93, 0x9e, 0x5a, 0x22, 0xc0, 0x4f,
+	0xff, 0xcb, 0x12, 0xdc, 0x3c, 0x97, 0x78, 0x0b, 0xa4, 0x55, 0xa0, 0xdf, 0x37, 0x5e, 0x40, 0x9e,
+	0x46, 0xcb, 0x51, 0xef, 0xdb, 0x13, 0xd4, 0xfb, 0x92, 0xda, 0x56, 0x89, 0xbc, 0x05, 0x30, 0xc1,
+	0xb7, 0x17, 0x72, 0x4a, 0xa1, 0x23, 0xb9, 0x0f, 0x4b, 0x23, 0xa9, 0x10, 0xc3, 0xc6, 0xd5, 0xae,
+	0x8e, 0xda, 0x86, 0x71, 0xec, 0xff, 0xbe, 0x04, 0x8b, 0x45, 0x2b, 0x3c, 0x12, 0x74, 0xd0, 0x23,
+	0x8f, 0xa0, 0x3e, 0xa2, 0x26, 0x6f, 0xa5, 0x7c, 0xb9, 0xcb, 0x63, 0xa4, 0x4b, 0xde, 0x86, 0x2a,
+	0x8b, 0xbb, 0x4c, 0xda, 0xc4, 0xfc, 0xce, 0xf3, 0x06, 0x31, 0x73, 0xaf, 0xed, 0xc4, 0x5d, 0x16,
+	0x18, 0xad, 0x1b, 0xbf, 0xf4, 0xa0, 0x82, 0x32, 0x1e, 0xab, 0xcc, 0x72, 0x11, 0x31, 0xeb, 0xee,
+	0x56, 0x22, 0x37, 0xa1, 0x6e, 0xbe, 0xc2, 0xe3, 0xd8, 0x06, 0x60, 0xcd, 0x00, 0xbb, 0x31, 0x59,
+	0x81, 0x46, 0xcc, 0xa4, 0xe2, 0xa9, 0xce, 0xa6, 0xb5, 0x19, 0x9b, 0x41, 0x11, 0xc2, 0x6c, 0xa0,
+	0x20, 0x86, 0xc7, 0xc6, 0x54, 0xcd, 0xa0, 0x59, 0x40, 0x77, 0x63, 0x93, 0xa9, 0xe0, 0x65, 0xa7,
+	0x03, 0xb3, 0x16, 0x58, 0xc9, 0xff, 0xda, 0x83, 0xeb, 0x53, 0x1b, 0xb0, 0xee, 0xf3, 0x0e, 0xcc,
+	0x0a, 0xfd, 0xe5, 0x0c, 0xf8, 0x92, 0x2e, 0xe0, 0xb4, 0x26, 0x7c, 0xa0, 0xf4, 0x43, 0x7d, 0xa0,
+	0x7c, 0xb1, 0x0f, 0xfc, 0xc2, 0x83, 0xf9, 0xf1, 0xb5, 0x90, 0x3d, 0x80, 0x42, 0x4e, 0x88, 0xaf,
+	0xa0, 0x4b, 0x79, 0x40, 0x41, 0x19, 0x43, 0xc1, 0x6c, 0xe9, 0x92, 0xa1, 0x60, 0x94, 0xfc, 0x37,
+	0x61, 0x71, 0x93, 0xa7, 0x54, 0x9c, 0x1d, 0x9e, 0x0d, 0x58, 0xc0, 0x3e, 0xcb, 0x99, 0x54, 0xe4,
+	0x06, 0xd4, 0x90, 0x51, 0x0b, 0xef, 0xa6, 0xa1, 0xec, 0xff, 0xba, 0x04, 0xa4, 0xa8, 0x21, 0x07,
+	0x59, 0x2a, 0x19, 0xb2, 0xab, 0xbb, 0x0b, 0xcc, 0xeb, 0xd1, 0x89, 0xe4, 0xfd, 0xb1, 0x05, 0xce,
+	0xaf, 0x3f, 0x98, 0x36, 0xf2, 0xd4, 0x70, 0x6b, 0x4f, 0x79, 0xfa, 0x60, 0xbd, 0x80, 0xbb, 0xe5,
+	0x7e, 0xe5, 0xc1, 0xc2, 0x44, 0x1b, 0x59, 0x82, 0x56, 0x67, 0xab, 0x13, 0x3e, 0x58, 0xdf, 0xdc,
+	0x3b, 0x0c, 0x37, 0xf7, 0x3e, 0xd8, 0x08, 0x3e, 0x69, 0x5d, 0x21, 0x04, 0xe6, 0x11, 0xdd, 0xde,
+	0xef, 0x38, 0xcc, 0x73, 0xd8, 0xd3, 0xfd, 0xa7, 0x0e, 0x2b, 0x39, 0xec, 0x60, 0x6f, 0xd7, 0x61,
+	0x65, 0x37, 0xe2, 0xc1, 0x7e, 0x67, 0xef, 0xff, 0x1c, 0x5a, 0x71, 0xe8, 0x7e, 0x67, 0xfd, 0xfe,
+	0x43, 0x87, 0x56, 0x1d, 0xfa, 0xf0, 0x3f, 0x0a, 0xb3, 0xcf, 0xf8, 0x3b, 0x70, 0x75, 0x2b, 0x61,
+	0x54, 0x74, 0x4c, 0xf2, 0xee, 0x0c, 0xdb, 0x86, 0x59, 0x9b, 0xce, 0x5b, 0xbb, 0x3a, 0x71, 0x94,
+	0xf0, 0x96, 0x8a, 0x09, 0xef, 0xc7, 0xb0, 0xbc, 0x11, 0x7d, 0x96, 0x73, 0xc1, 0x26, 0x06, 0x5a,
+	0x82, 0x2a, 0x4f, 0x63, 0x76, 0x6a, 0xe3, 0xd5, 0x08, 0x98, 0xf8, 0xc4, 0xb9, 0x30, 0xc1, 0x56,
+	0xb8, 0x9e, 0x2a, 0xc1, 0xbc, 0x83, 0xcd, 0x15, 0xe1, 0x7f, 0x0a, 0x73, 0x76, 0xc0, 0xc7, 0x38,
+	0xcf, 0x05, 0xc3, 0x2d, 0x41, 0x55, 0x65, 0x27, 0x2c, 0x75, 0x6b, 0xd2, 0x02, 0x59, 0x83, 0xab,
+	0xec, 0x74, 0xc0, 0x05, 0x93, 0x61, 0x9e, 0xf2, 0xd3, 0xe2, 0xa5, 0x55, 0x09, 0x16, 0x6d, 0xd3,
+	0x47, 0x29, 0x3f, 0xb5, 0x73, 0x05, 0xb0, 0x18, 0xb0, 0x94, 0x7d, 0xae, 0x67, 0x2a, 0xac, 0xdf,
+	0x0c, 0xed, 0x15, 0x87, 0x7e, 0xe9, 0xf5, 0x6f, 0xc1, 0x72, 0xc0, 0xb4, 0x89, 0xa6, 0xed, 0x72,
+	0xce, 0xb8, 0x4b, 0x50, 0x8d, 0xf0, 0x34, 0xf4, 0x68, 0xb5, 0xc0, 0x08, 0x3e, 0x85, 0x85, 0x4e,
+	0x4a, 0x07, 0xb2, 0x97, 0x29, 0xa7, 0x3e, 0xce, 0x83, 0xf5, 0x21, 0x0f, 0x4e, 0x50, 0x9d, 0x2d,
+	0x12, 0x14, 0xa0, 0xd1, 0xf9, 0x95, 0x8b, 0xe7, 0x97, 0xc2, 0xc2, 0x5e, 0xcc, 0x52, 0xc5, 0x8f,
+	0xcf, 0xdc, 0x14, 0xba, 0xe0, 0x60, 0xfd, 0x1f, 0x5f, 0x79, 0x9e, 0x2b, 0x38, 0x58, 0x6c, 0x2f,
+	0xc6, 0x44, 0xa6, 0x9f, 0xa5, 0xdd, 0x2c, 0xec, 0x65, 0x52, 0xd9, 0xc9, 0xea, 0x1a, 0x79, 0x37,
+	0x93, 0x8a, 0xbc, 0x02, 0x35, 0xd3, 0x1c, 0x1f, 0xd9, 0xd9, 0x66, 0xb5, 0xbc, 0x7d, 0xe4, 0xbf,
+	0x05, 0xf5, 0x6d, 0x2e, 0x4f, 0x3e, 0x92, 0x36, 0x73, 0xfa, 0x2c, 0xcf, 0x14, 0xb5, 0xf7, 0xb7,
+	0x11, 0x30, 0xaf, 0xc9, 0x25, 0x8b, 0xad, 0x61, 0xf5, 0xb7, 0xff, 0x3b, 0x0f, 0x5a, 0xce, 0x1f,
+	0x32, 0x53, 0x1c, 0x91, 0xe8, 0xab, 0x51, 0xd6, 0x1f, 0xf0, 0xc4, 0x19, 0xc3, 0x89, 0x78, 0xd9,
+	0x8b, 0xdc, 0x59, 0x01, 0x3f, 0xc9, 0xdb, 0x30, 0x67, 0x1b, 0xc3, 0x98, 0xcb, 0x13, 0x7b, 0xa5,
+	0xde, 0x98, 0x8a, 0xf4, 0xe1, 0xe2, 0x82, 0x86, 0xed, 0x8f, 0x08, 0x79, 0x0b, 0x6a, 0x22, 0x4f,
+	0x8d, 0x6a, 0xe5, 0x85, 0xaa, 0xb3, 0x22, 0x4f, 0x51, 0xf2, 0xbf, 0x2d, 0x43, 0x6b, 0x64, 0x5e,
+	0x4b, 0x44, 0xaf, 0x01, 0xf0, 0xf4, 0x59, 0x76, 0x52, 0xb4, 0x6e, 0xdd, 0x22, 0x7b, 0xf8, 0xea,
+	0x73, 0x0f, 0xea, 0xe1, 0xad, 0x39, 0x9d, 0x50, 0x4f, 0xda, 0x22, 0x18, 0xe9, 0x4c, 0x96, 0x5a,
+	0xca, 0xdf, 0xab, 0xd4, 0x82, 0x04, 0x9b, 0x50, 0x75, 0x9c, 0x89, 0x7e, 0xbb, 0x62, 0x09, 0xd6,
+	0xca, 0x78, 0x69, 0x22, 0xd9, 0x86, 0x92, 0x21, 0xcb, 0xab, 0x4c, 0xd8, 0x74, 0xb5, 0x89, 0x68,
+	0xc7, 0x81, 0x78, 0xba, 0x68, 0x2f, 0x2c, 0x08, 0x61, 0x45, 0xc1, 0x08, 0xc4, 0x07, 0x2c, 0xbb,
+	0x74, 0x05, 0xed, 0xef, 0xf2, 0x44, 0xbf, 0x7b, 0xb0, 0x71, 0x0c, 0x43, 0x0f, 0x94, 0x03, 0xc6,
+	0xe2, 0xf0, 0x98, 0x46, 0x38, 0x3c, 0x3e, 0x7b, 0xbc, 0xa0, 0xa1, 0xb1, 0x5d, 0x0d, 0x91, 0xf7,
+	0x60, 0x3e, 0xce, 0x3e, 0x4f, 0x93, 0x8c, 0xc6, 0x61, 0x44, 0xa3, 0x1e, 0x6b, 0xd7, 0x2f, 0x28,
+	0x9d, 0x6c, 0xdb, 0x6e, 0x5b, 0xd8, 0x0b, 0x33, 0x4a, 0x19, 0x34, 0xe3, 0x22, 0xa6, 0xcb, 0x67,
+	0x34, 0xe1, 0x47, 0x36, 0xae, 0x4d, 0x76, 0x0e, 0xb6, 0x7c, 0x36, 0x6a, 0xd0, 0x49, 0x3a, 0x7a,
+	0x22, 0x99, 0x1e, 0x12, 0x9d, 0xb6, 0xc7, 0x95, 0xb4, 0x9e, 0xac, 0xbf, 0x75, 0x2a, 0xca, 0x75,
+	0x06, 0xe5, 0x52, 0x51, 0x2d, 0x91, 0x57, 0xa1, 0xce, 0x9e, 0xf1, 0x48, 0x1f, 0x9c, 0x65, 0xa5,
+	0x11, 0x80, 0x5e, 0xcd, 0x52, 0x25, 0x38, 0x73, 0x55, 0x39, 0x27, 0xda, 0xe7, 0x43, 0x1c, 0x1e,
+	0x9d, 0x29, 0x26, 0x6d, 0x2d, 0x0e, 0x9f, 0x0f, 0xf1, 0x26, 0x02, 0x98, 0x0a, 0xf5, 0xe9, 0xa9,
+	0x6d, 0x9d, 0xd1, 0xad, 0xb5, 0x3e, 0x3d, 0xd5, 0x8d, 0x3e, 0x81, 0xd6, 0x96, 0xdd, 0x8a, 0xa3,
+	0x38, 0xff, 0x21, 0x2c, 0x16, 0x30, 0xeb, 0x9d, 0x93, 0xb6, 0xf7, 0xa6, 0x6c, 0xef, 0xff, 0xcd,
+	0x83, 0x1a, 0x1e, 0x14, 0xee, 0xbc, 0x50, 0x28, 0xf5, 0x86, 0x85, 0xd2, 0xdb, 0x30, 0xc7, 0x65,
+	0xa1, 0x14, 0x69, 0x48, 0xad, 0xc1, 0xe5, 0xa8, 0x0a, 0x49, 0xa0, 0x22, 0xf9, 0x17, 0xcc, 0x6e,
+	0x5f, 0x7f, 0xa3, 0xcf, 0xe9, 0xa2, 0xa1, 0xcc, 0x87, 0x3e, 0xe7, 0x64, 0xec, 0xdf, 0xc7, 0xb7,
+	0x7a, 0xd5, 0x3c, 0x76, 0xf0, 0x1b, 0xa7, 0xe9, 0x17, 0x5f, 0x25, 0xb8, 0xe7, 0x72, 0xd0, 0xd0,
+	0x98, 0x7d, 0x69, 0xb4, 0xa0, 0x9c, 0xf3, 0x58, 0x3f, 0xae, 0x9b, 0x01, 0x7e, 0x22, 0xd2, 0xe5,
+	0xb1, 0x76, 0xa9, 0x66, 0x80, 0x9f, 0xe8, 0xce, 0xf2, 0xac, 0x9f, 0xf0, 0xf4, 0x24, 0x54, 0x54,
+	0x74, 0x99, 0xd2, 0xae, 0x54, 0x0f, 0x9a, 0x16, 0x3d, 0xd4, 0xa0, 0xff, 0x9b, 0x12, 0x34, 0x70,
+	0xc7, 0x8e, 0x26, 0x47, 0x1b, 0x2f, 0x0f, 0x37, 0x3e, 0x5e, 0x1e, 0x2b, 0x4d, 0x96, 0xc7, 0x2e,
+	0x28, 0x15, 0x93, 0x7f, 0x07, 0x12, 0xd1, 0x24, 0xca, 0x13, 0xaa, 0x58, 0x38, 0x66, 0x82, 0x5a,
+	0xb0, 0x38, 0x6c, 0xd9, 0x72, 0xb6, 0x18, 0x32, 0x79, 0xb5, 0xc0, 0xe4, 0xe8, 0x55, 0x82, 0x45,
+	0xb9, 0x90, 0xfc, 0x19, 0xb3, 0x35, 0xd8, 0x11, 0xe0, 0x9c, 0x23, 0x66, 0x03, 0xd5, 0xb3, 0xe6,
+	0x40, 0xe7, 0xd8, 0x46, 0x19, 0x8b, 0x08, 0xd8, 0xe8, 0xdc, 0xce, 0xd8, 0x06, 0xfa, 0xf4, 0x74,
+	0xc7, 0x20, 0x7a, 0x81, 0x76, 0xf6, 0x90, 0x26, 0xdd, 0x4c, 0x70, 0xd5, 0xeb, 0x5b, 0x33, 0x2d,
+	0xba, 0x96, 0x0d, 0xd7, 0xe0, 0xff, 0xca, 0x83, 0xba, 0x73, 0x10, 0x49, 0x1e, 0x8c, 0x1c, 0xda,
+	0x24, 0xc2, 0xaf, 0x4c, 0xc5, 0xa8, 0xeb, 0x3c, 0xf2, 0xf5, 0x57, 0xa1, 0x3e, 0x4a, 0x5d, 0x8d,
+	0xff, 0x8c, 0x00, 0xf2, 0xbf, 0xc8, 0xe6, 0x83, 0xb3, 0xd0, 0x25, 0xd8, 0x65, 0x3d, 0xee, 0xcd,
+	0xa9, 0x71, 0xb7, 0xb2, 0xc1, 0x99, 0x4d, 0x27, 0x1b, 0xd1, 0xf0, 0x5b, 0xfa, 0x0c, 0xe0, 0x11,
+	0x3b, 0xe7, 0x24, 0xc7, 0x6a, 0xfd, 0xd3, 0xd9, 0xce, 0x05, 0x76, 0x28, 0x5f, 0x64, 0x87, 0xaf,
+	0x3d, 0x58, 0x38, 0xc8, 0xd5, 0x56, 0x2f, 0x4f, 0x4f, 0x9e, 0x37, 0xd9, 0x35, 0x98, 0xc9, 0x8e,
+	0x8f, 0x25, 0x53, 0x8e, 0x28, 0x8c, 0x44, 0xee, 0x42, 0x25, 0xa6, 0x8a, 0x3e, 0xff, 0xfd, 0xa7,
+	0xbb, 0xe0, 0x7a, 0x8f, 0x39, 0x56, 0x80, 0x8c, 0xd7, 0x18, 0x41, 0x47, 0x59, 0x8f, 0xde, 0xd7,
+	0x8e, 0x32, 0x17, 0xe8, 0xef, 0xd1, 0xce, 0x66, 0x8a, 0x3b, 0x2b, 0xc6, 0xde, 0xec, 0x78, 0xec,
+	0xf9, 0x27, 0xb0, 0xf0, 0x88, 0x7d, 0xff, 0x5d, 0x5c, 0x83, 0x99, 0x84, 0xa5, 0x5d, 0x5b, 0x0b,
+	0xa9, 0x04, 0x56, 0x1a, 0x2d, 0xa4, 0x52, 0x4c, 0x48, 0x7e, 0x66, 0x7d, 0x47, 0x4f, 0x57, 0x18,
+	0xd3, 0x3b, 0xd7, 0x32, 0xa5, 0x17, 0x5b, 0xe6, 0x26, 0xd4, 0x8f, 0xf1, 0xda, 0x2f, 0xd0, 0x4d,
+	0x0d, 0x81, 0x0e, 0x52, 0x4e, 0x0b, 0xca, 0x2c, 0x3b, 0xb6, 0x46, 0xc3, 0xcf, 0xf3, 0x4c, 0xe6,
+	0xff, 0xd6, 0x83, 0xc5, 0x83, 0x5c, 0x6d, 0x88, 0xa8, 0xc7, 0x9f, 0x0d, 0x33, 0xc4, 0x89, 0x94,
+	0xcb, 0x98, 0xa2, 0x08, 0x5d, 0x66, 0x95, 0x0f, 0x61, 0x06, 0xef, 0x56, 0x6a, 0xee, 0xeb, 0xf9,
+	0xf5, 0x5b, 0x53, 0x9d, 0xed, 0xec, 0xbb, 0xba, 0x57, 0x60, 0x7b, 0x5f, 0x60, 0xc4, 0x9f, 0x7a,
+	0xb0, 0xf8, 0x88, 0x4d, 0x2e, 0xf8, 0x82, 0x43, 0xb3, 0x94, 0x54, 0x1a, 0xa3, 0xa4, 0x7f, 0xec,
+	0x7a, 0x7e, 0xec, 0xc1, 0xac, 0xed, 0x3f, 0x34, 0x8a, 0x77, 0x19, 0xa3, 0x94, 0x2e, 0xbb, 0x88,
+	0x63, 0x9d, 0x5c, 0x98, 0x17, 0xbf, 0x11, 0xfc, 0x79, 0x98, 0xdb, 0xc1, 0xff, 0x0b, 0x9f, 0xd8,
+	0xa2, 0xda, 0x5f, 0x3d, 0x68, 0x22, 0x41, 0xec, 0x0f, 0x98, 0xb9, 0xe0, 0xc9, 0xbf, 0xc0, 0x42,
+	0x82, 0x59, 0x51, 0xa8, 0x1d, 0xa6, 0x70, 0xad, 0x35, 0x35, 0x8c, 0x6e, 0xa9, 0xff, 0x92, 0xbb,
+	0x03, 0x0b, 0x82, 0xf5, 0x33, 0xc5, 0xc2, 0xc4, 0x26, 0x60, 0x96, 0x26, 0xe6, 0x0d, 0xec, 0xd2,
+	0x32, 0xb4, 0x6e, 0x3e, 0xc0, 0x4c, 0xc1, 0x11, 0xbe, 0x91, 0x9e, 0x7b, 0xd3, 0x21, 0x19, 0x67,
+	0x71, 0x9e, 0xb0, 0x50, 0x9d, 0x0d, 0x1c, 0xc7, 0x83, 0x81, 0xf4, 0x6b, 0xf2, 0x4d, 0xb8, 0x4a,
+	0x73, 0xd5, 0xcb, 0x04, 0xff, 0xc2, 0x24, 0x2c, 0xe6, 0x3d, 0x61, 0xc2, 0x99, 0x8c, 0x35, 0x1d,
+	0x62, 0x0b, 0xfe, 0x95, 0x3a, 0x3f, 0xb6, 0x51, 0xac, 0xa9, 0x4f, 0x70, 0xf2, 0xad, 0x73, 0xb9,
+	0x73, 0xa8, 0x31, 0x96, 0x84, 0x3c, 0xef, 0x8a, 0x3b, 0xf7, 0x95, 0x81, 0xa1, 0x82, 0x59, 0x61,
+	0x92, 0xb0, 0x84, 0xcb, 0xbe, 0xad, 0xb1, 0x14, 0x21, 0x1d, 0xa5, 0x94, 0x27, 0xe1, 0x31, 0x95,
+	0xca, 0x16, 0x59, 0x6a, 0x08, 0xec, 0x52, 0xa9, 0xf0, 0x2f, 0x12, 0x18, 0x51, 0xb9, 0xf9, 0xa7,
+	0x8e, 0xaa, 0xdc, 0x64, 0x5b, 0xf3, 0xeb, 0xfe, 0x73, 0x78, 0x7f, 0xad, 0xa3, 0x7b, 0x06, 0x56,
+	0xc3, 0x16, 0x6b, 0x33, 0xe1, 0x78, 0x5d, 0x0b, 0x88, 0x9a, 0xb4, 0xc9, 0xf0, 0x83, 0x11, 0xce,
+	0x7b, 0xec, 0x55, 0xce, 0x7d, 0xec, 0xdd, 0x85, 0x19, 0x33, 0x0d, 0x99, 0x81, 0xd2, 0xfe, 0xfb,
+	0xad, 0x2b, 0x04, 0x60, 0x66, 0x77, 0x63, 0xef, 0xf1, 0xce, 0x76, 0xcb, 0x23, 0x0d, 0x98, 0xed,
+	0xbc, 0xbf, 0x77, 0x70, 0xb0, 0xb3, 0xdd, 0x2a, 0xf9, 0xdb, 0x50, 0x43, 0x17, 0x3a, 0xa0, 0x5c,
+	0x8c, 0xbd, 0xe5, 0x4a, 0xcf, 0x7b, 0xcb, 0x4d, 0x12, 0x8b, 0xff, 0xa5, 0x07, 0xd7, 0x4d, 0x89,
+	0x94, 0xc5, 0x6e, 0x38, 0x77, 0x57, 0xbf, 0xc4, 0x75, 0xeb, 0x54, 0x7e, 0xd8, 0xa9, 0xfa, 0x8f,
+	0x60, 0xd9, 0x2d, 0xa2, 0xa3, 0x04, 0x4f, 0xbb, 0x6e, 0x09, 0xed, 0xf1, 0x25, 0xd4, 0x47, 0xf3,
+	0x9c, 0x7b, 0xad, 0xde, 0xbb, 0x0d, 0xcd, 0xb1, 0x40, 0x26, 0xb3, 0x50, 0x3e, 0xdc, 0x08, 0x5a,
+	0x57, 0xf0, 0xe3, 0xff, 0xf7, 0x0e, 0x5a, 0xde, 0xe6, 0xda, 0x37, 0xdf, 0xdd, 0xf2, 0xbe, 0xfd,
+	0xee, 0x96, 0xf7, 0xe7, 0xef, 0x6e, 0x79, 0x3f, 0xff, 0xcb, 0xad, 0x2b, 0xf0, 0x6a, 0x26, 0xba,
+	0x6b, 0x68, 0x92, 0xae, 0xa0, 0x67, 0x93, 0x5b, 0xfc, 0xfb, 0x00, 0x84, 0x45, 0x0f, 0x8b, 0x7b,
+	0x20, 0x00, 0x00,
 }
@@ -0,0 +1,69 @@
+package contester_proto
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// Supported checksum algorithms. Checksums are written as algo:hex.
+const (
+	CHECKSUM_SHA1   = "sha1"
+	CHECKSUM_SHA256 = "sha256"
+	CHECKSUM_BLAKE3 = "blake3" // available once tools/blake3 is linked in
+)
+
+var hashes = map[string]func() hash.Hash{
+	CHECKSUM_SHA1:   sha1.New,
+	CHECKSUM_SHA256: sha256.New,
+}
+
+// Make the algorithm available for checksums. Algorithms outside of the standard library
+// are registered from init of their own packages, so this one has no other dependencies.
+func RegisterHash(algo string, fn func() hash.Hash) {
+	hashes[algo] = fn
+}
+
+func NewHash(algo string) (hash.Hash, error) {
+	if fn := hashes[algo]; fn != nil {
+		return fn(), nil
+	}
+	return nil, errors.NotSupportedf("Checksum algorithm %q", algo)
+}
+
+func FormatChecksum(algo string, sum []byte) string {
+	return algo + ":" + hex.EncodeToString(sum)
+}
+
+// Split algo:hex into the algorithm and the raw sum. The algorithm must be supported.
+func ParseChecksum(checksum string) (string, []byte, error) {
+	parts := strings.SplitN(checksum, ":", 2)
+	if len(parts) != 2 {
+		return "", nil, errors.NotValidf("Checksum %q", checksum)
+	}
+	algo := strings.ToLower(parts[0])
+	if _, err := NewHash(algo); err != nil {
+		return "", nil, err
+	}
+	sum, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return "", nil, errors.NotValidf("Checksum %q", checksum)
+	}
+	return algo, sum, nil
+}
+
+func CalcChecksum(algo string, r io.Reader) (string, error) {
+	h, err := NewHash(algo)
+	if err != nil {
+		return "", err
+	}
+	if _, err = io.Copy(h, r); err != nil {
+		return "", errors.Annotate(err, "io.Copy")
+	}
+	return FormatChecksum(algo, h.Sum(nil)), nil
+}
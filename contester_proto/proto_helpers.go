@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"compress/zlib"
 	"crypto/sha1"
+	"hash"
 	"io"
 
 	"github.com/golang/protobuf/proto"
@@ -35,6 +36,80 @@ func (blob *Blob) Bytes() ([]byte, error) {
 	return result.Bytes(), nil
 }
 
+// Reader for the uncompressed data, failing at the end of it if the data doesn't match
+// the SHA1 or the digest of the blob.
+func (blob *Blob) VerifiedReader() (io.Reader, error) {
+	r, err := blob.Reader()
+	if err != nil {
+		return nil, err
+	}
+	v := &verifyingReader{r: r}
+	if blob.Sha1 != nil {
+		v.add(CHECKSUM_SHA1, blob.Sha1)
+	}
+	if blob.Digest != nil {
+		algo, sum, err := ParseChecksum(blob.GetDigest())
+		if err != nil {
+			return nil, err
+		}
+		v.add(algo, sum)
+	}
+	return v, nil
+}
+
+// Checksum of the uncompressed data as algo:hex: the digest, or SHA1 if there's none.
+func (blob *Blob) Checksum() string {
+	if blob.Digest != nil {
+		return blob.GetDigest()
+	}
+	if blob.Sha1 != nil {
+		return FormatChecksum(CHECKSUM_SHA1, blob.Sha1)
+	}
+	return ""
+}
+
+// Uncompressed data, checked against the SHA1 and the digest if the blob has them.
+func (blob *Blob) VerifiedBytes() ([]byte, error) {
+	reader, err := blob.VerifiedReader()
+	if err != nil {
+		return nil, err
+	}
+	var result bytes.Buffer
+	if _, err = io.Copy(&result, reader); err != nil {
+		return nil, err
+	}
+	return result.Bytes(), nil
+}
+
+type verifyingReader struct {
+	r        io.Reader
+	algos    []string
+	hashes   []hash.Hash
+	expected [][]byte
+}
+
+func (v *verifyingReader) add(algo string, sum []byte) {
+	h, _ := NewHash(algo)
+	v.algos = append(v.algos, algo)
+	v.hashes = append(v.hashes, h)
+	v.expected = append(v.expected, sum)
+}
+
+func (v *verifyingReader) Read(p []byte) (int, error) {
+	n, err := v.r.Read(p)
+	for _, h := range v.hashes {
+		h.Write(p[:n])
+	}
+	if err == io.EOF {
+		for i, h := range v.hashes {
+			if sum := h.Sum(nil); !bytes.Equal(sum, v.expected[i]) {
+				return n, errors.NotValidf("Blob %s %x, data has %x,", v.algos[i], v.expected[i], sum)
+			}
+		}
+	}
+	return n, err
+}
+
 func compress(data []byte) ([]byte, error) {
 	var result bytes.Buffer
 	writer := zlib.NewWriter(&result)
@@ -45,21 +120,25 @@ func compress(data []byte) ([]byte, error) {
 	return result.Bytes(), nil
 }
 
-func calcSha1(data []byte) ([]byte, error) {
-	result := sha1.New()
-	if _, err := io.Copy(result, bytes.NewBuffer(data)); err != nil {
-		return nil, err
-	}
-	return result.Sum(nil), nil
+func NewBlob(data []byte) (*Blob, error) {
+	return NewDigestBlob(data, CHECKSUM_SHA1)
 }
 
-func NewBlob(data []byte) (*Blob, error) {
+// Same as NewBlob, with the digest in the given algorithm. SHA1 is always filled in for
+// older readers, so the digest is only added for other algorithms.
+func NewDigestBlob(data []byte, algo string) (*Blob, error) {
 	if data == nil {
 		return nil, nil
 	}
-	sha1sum, err := calcSha1(data)
-	if err != nil {
-		return nil, err
+	sha1sum := sha1.Sum(data)
+	result := &Blob{Sha1: sha1sum[:]}
+	if algo != CHECKSUM_SHA1 {
+		h, err := NewHash(algo)
+		if err != nil {
+			return nil, err
+		}
+		h.Write(data)
+		result.Digest = proto.String(FormatChecksum(algo, h.Sum(nil)))
 	}
 
 	compressed, err := compress(data)
@@ -67,9 +146,6 @@ func NewBlob(data []byte) (*Blob, error) {
 		return nil, err
 	}
 
-	result := &Blob{
-		Sha1: sha1sum,
-	}
 	if len(compressed) < len(data)-8 {
 		method := Blob_CompressionInfo_METHOD_ZLIB
 		result.Compression = &Blob_CompressionInfo{
@@ -84,10 +160,19 @@ func NewBlob(data []byte) (*Blob, error) {
 }
 
 func BlobFromStream(r io.Reader) (*Blob, error) {
+	return DigestBlobFromStream(r, CHECKSUM_SHA1)
+}
+
+// Same as BlobFromStream, with the digest in the given algorithm.
+func DigestBlobFromStream(r io.Reader, algo string) (*Blob, error) {
+	h, err := NewHash(algo)
+	if err != nil {
+		return nil, err
+	}
 	var compressed bytes.Buffer
 	compressor := zlib.NewWriter(&compressed)
 	shaCalculator := sha1.New()
-	writer := io.MultiWriter(compressor, shaCalculator)
+	writer := io.MultiWriter(compressor, shaCalculator, h)
 
 	size, err := io.Copy(writer, r)
 	if err != nil {
@@ -96,8 +181,9 @@ func BlobFromStream(r io.Reader) (*Blob, error) {
 	compressor.Close()
 	method := Blob_CompressionInfo_METHOD_ZLIB
 	result := &Blob{
-		Sha1: shaCalculator.Sum(nil),
-		Data: compressed.Bytes(),
+		Sha1:   shaCalculator.Sum(nil),
+		Digest: proto.String(FormatChecksum(algo, h.Sum(nil))),
+		Data:   compressed.Bytes(),
 		Compression: &Blob_CompressionInfo{
 			Method:       &method,
 			OriginalSize: proto.Uint32(uint32(size)),
@@ -3,6 +3,7 @@
 
 #include <sched.h>
 #include <sys/capability.h>
+#include <sys/ioctl.h>
 #include <sys/ptrace.h>
 #include <sys/types.h>
 #include <unistd.h>
@@ -55,10 +56,31 @@ int Exec(const struct CloneParams& params) {
               Status(params.commfd, 16 + i, syscalls.errno_);
               return -1;
           }
-         close(params.stdhandles[i]);
       }
   }
 
+  // Same handle may be used for several descriptors (pty), close it once all are dup'ed.
+  for (int i = 0; i < 3; ++i) {
+      if (params.stdhandles[i] > 2) {
+          bool seen = false;
+          for (int j = 0; j < i; ++j)
+              seen = seen || params.stdhandles[j] == params.stdhandles[i];
+          if (!seen)
+              close(params.stdhandles[i]);
+      }
+  }
+
+  if (params.ctty != -1) {
+    if (syscalls.setsid() < 0) {
+      Status(params.commfd, 5, syscalls.errno_);
+      return -1;
+    }
+    if (syscalls.ioctl(params.ctty, TIOCSCTTY, 0) < 0) {
+      Status(params.commfd, 6, syscalls.errno_);
+      return -1;
+    }
+  }
+
   if (params.cwd && (syscalls.chdir(params.cwd) < 0)) {
     Status(params.commfd, 1, syscalls.errno_);
     return -1;
@@ -14,6 +14,7 @@ struct CloneParams {
   char *cwd;
   uint32_t suid;
   int32_t stdhandles[3];
+  int32_t ctty;  // index of stdhandle to become controlling terminal, or -1
   int32_t commfd;
 
   char *tls;
@@ -11,15 +11,50 @@ import (
 	"syscall"
 )
 
+// Stage codes reported by the clone helper.
+const (
+	STAGE_CHDIR     = 1
+	STAGE_SETUID    = 2
+	STAGE_PTRACE    = 3
+	STAGE_EXEC      = 4
+	STAGE_SETSID    = 5
+	STAGE_SETCTTY   = 6
+	STAGE_DUP_STDIN = 16 // +1 for stdout, +2 for stderr
+)
+
 var childStages = map[int]string{
-	1: "chdir",
-	2: "setuid",
-	3: "ptrace",
-	4: "exec",
+	STAGE_CHDIR:         "chdir",
+	STAGE_SETUID:        "setuid",
+	STAGE_PTRACE:        "ptrace",
+	STAGE_EXEC:          "exec",
+	STAGE_SETSID:        "setsid",
+	STAGE_SETCTTY:       "TIOCSCTTY",
+	STAGE_DUP_STDIN:     "dup2(stdin)",
+	STAGE_DUP_STDIN + 1: "dup2(stdout)",
+	STAGE_DUP_STDIN + 2: "dup2(stderr)",
+}
+
+// Failure of the child between clone and exec.
+type ChildError struct {
+	Stage int
+	Errno syscall.Errno
+}
+
+func (e *ChildError) StageName() string {
+	if w, ok := childStages[e.Stage]; ok {
+		return w
+	}
+	return strconv.Itoa(e.Stage)
+}
+
+func (e *ChildError) Error() string {
+	return e.StageName() + ": " + e.Errno.Error()
 }
 
 type StdHandles struct {
 	StdIn, StdOut, StdErr *os.File
+	// If set, becomes the controlling terminal of the child. Must be one of the above.
+	Terminal *os.File
 }
 
 type CommStatus struct {
@@ -64,11 +99,10 @@ func commReader(r *os.File, sig chan CommStatus) {
 }
 
 func childError(c CommStatus) error {
-	w, ok := childStages[c.What]
-	if !ok {
-		w = strconv.Itoa(c.What)
+	if errno, ok := c.Err.(syscall.Errno); ok && c.What != 0 {
+		return &ChildError{Stage: c.What, Errno: errno}
 	}
-	return os.NewSyscallError(w, c.Err)
+	return c.Err
 }
 
 func (c *CloneParams) CloneFrozen() (int, error) {
@@ -81,6 +81,14 @@ func CreateCloneParams(filename string, args []string, env *[]string, cwd *strin
 	result.repr.stdhandles[1] = getFd(result.stdhandles.StdOut)
 	result.repr.stdhandles[2] = getFd(result.stdhandles.StdErr)
 
+	result.repr.ctty = -1
+	for i, f := range []*os.File{result.stdhandles.StdIn, result.stdhandles.StdOut, result.stdhandles.StdErr} {
+		if f != nil && f == result.stdhandles.Terminal {
+			result.repr.ctty = C.int32_t(i)
+			break
+		}
+	}
+
 	runtime.SetFinalizer(result, freeCloneParams)
 	return result, nil
 }
@@ -0,0 +1,91 @@
+// +build linux
+
+package linux
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// These syscall numbers are shared by all architectures since 4.20.
+const (
+	sysPidfdSendSignal = 424
+	sysPidfdOpen       = 434
+
+	pPidfd = 3 // idtype_t P_PIDFD, linux 5.4+
+
+	cldExited    = 1
+	cldKilled    = 2
+	cldDumped    = 3
+	cldTrapped   = 4
+	cldStopped   = 5
+	cldContinued = 6
+)
+
+// siginfo_t as filled in by waitid(2). The union starts at the first pointer-aligned
+// offset after three int32 fields; whole structure is 128 bytes.
+type siginfo struct {
+	Signo, Errno, Code int32
+	union              [(128 - 12) / unsafe.Sizeof(uintptr(0))]uintptr
+}
+
+func (s *siginfo) status() int32 {
+	// union { pid_t si_pid; uid_t si_uid; int si_status; ... }
+	return *(*int32)(unsafe.Pointer(uintptr(unsafe.Pointer(&s.union[0])) + 8))
+}
+
+// Convert siginfo to the wait(2)-style status, so callers can use syscall.WaitStatus methods.
+func (s *siginfo) waitStatus() syscall.WaitStatus {
+	st := uint32(s.status())
+	switch s.Code {
+	case cldExited:
+		return syscall.WaitStatus(st << 8)
+	case cldKilled:
+		return syscall.WaitStatus(st)
+	case cldDumped:
+		return syscall.WaitStatus(st | 0x80)
+	case cldTrapped, cldStopped:
+		return syscall.WaitStatus(st<<8 | 0x7f)
+	case cldContinued:
+		return syscall.WaitStatus(0xffff)
+	}
+	return 0
+}
+
+// Open a pidfd for the given process. Fails with ENOSYS on kernels older than 5.3,
+// in which case callers should stick to the pid.
+// The pid must belong to our unreaped child, otherwise the result is racy.
+func PidfdOpen(pid int) (int, error) {
+	fd, _, e := syscall.Syscall(sysPidfdOpen, uintptr(pid), 0, 0)
+	if e != 0 {
+		return -1, os.NewSyscallError("pidfd_open", e)
+	}
+	return int(fd), nil
+}
+
+func PidfdSendSignal(pidfd int, sig syscall.Signal) error {
+	_, _, e := syscall.Syscall6(sysPidfdSendSignal, uintptr(pidfd), uintptr(sig), 0, 0, 0, 0)
+	if e != 0 {
+		return os.NewSyscallError("pidfd_send_signal", e)
+	}
+	return nil
+}
+
+// Wait for the state change of the process referred to by pidfd. Options are the same
+// as for waitid(2), i.e. WEXITED must be present to wait for termination.
+// Fails with EINVAL on kernels without P_PIDFD support (< 5.4).
+func PidfdWait(pidfd int, options int, rusage *syscall.Rusage) (syscall.WaitStatus, error) {
+	var info siginfo
+	for {
+		_, _, e := syscall.Syscall6(syscall.SYS_WAITID, pPidfd, uintptr(pidfd),
+			uintptr(unsafe.Pointer(&info)), uintptr(options), uintptr(unsafe.Pointer(rusage)), 0)
+		switch e {
+		case 0:
+			return info.waitStatus(), nil
+		case syscall.EINTR:
+			continue
+		}
+		return 0, os.NewSyscallError("waitid", e)
+	}
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -48,6 +49,9 @@ type RunexeConfig struct {
 	Logfile             string
 	RecordProgramInput  string
 	RecordProgramOutput string
+	Transcript          string
+	Repeat              int
+	RepeatPolicy        RepeatPolicyFlag
 }
 
 type ProcessType int
@@ -100,6 +104,9 @@ func AddGlobalFlags(fs *flag.FlagSet) *RunexeConfig {
 	fs.StringVar(&result.Logfile, "logfile", "", "")
 	fs.StringVar(&result.RecordProgramInput, "ri", "", "")
 	fs.StringVar(&result.RecordProgramOutput, "ro", "", "")
+	fs.StringVar(&result.Transcript, "transcript", "", "")
+	fs.IntVar(&result.Repeat, "repeat", 1, "")
+	fs.Var(&result.RepeatPolicy, "repeat-policy", "")
 	fs.BoolVar(&result.ShowKernelModeTime, "show-kernel-mode-time", false, "")
 	fs.BoolVar(&result.ReturnExitCode, "x", false, "")
 	return &result
@@ -193,11 +200,8 @@ func SetupSubprocess(s *ProcessConfig, desktop *platform.ContesterDesktop, loadL
 	return sub, nil
 }
 
-func ExecAndSend(sub *subprocess.Subprocess, c chan RunResult, ptype ProcessType) {
-	var r RunResult
-	r.T = ptype
-	r.S = sub
-	r.R, r.E = sub.Execute()
+func newRunResult(sub *subprocess.Subprocess, ptype ProcessType, result *subprocess.SubprocessResult, err error) *RunResult {
+	r := &RunResult{T: ptype, S: sub, R: result, E: err}
 	if r.E != nil {
 		if subprocess.IsUserError(r.E) {
 			r.V = CRASH
@@ -207,7 +211,15 @@ func ExecAndSend(sub *subprocess.Subprocess, c chan RunResult, ptype ProcessType
 	} else {
 		r.V = GetVerdict(r.R)
 	}
-	c <- r
+	return r
+}
+
+// Returns nil interface if file is nil.
+func fileRecorder(f *os.File) io.Writer {
+	if f == nil {
+		return nil
+	}
+	return f
 }
 
 func ParseFlags(globals bool, args []string) (pc *ProcessConfig, gc *RunexeConfig, err error) {
@@ -260,6 +272,7 @@ func main() {
 	}
 
 	var program, interactor *subprocess.Subprocess
+	var topology *subprocess.Topology
 	program, err = SetupSubprocess(programFlags, desktop, loadLibrary)
 	if err != nil {
 		Fail(globalFlags.Xml, err, "Setup main subprocess")
@@ -286,31 +299,51 @@ func main() {
 			}
 		}
 
-		err = subprocess.Interconnect(program, interactor, recordI, recordO)
+		var transcriptI, transcriptO io.Writer
+		if globalFlags.Transcript != "" {
+			transcriptFile, err := os.Create(globalFlags.Transcript)
+			if err != nil {
+				Fail(globalFlags.Xml, err, "Create transcript")
+			}
+			defer transcriptFile.Close()
+			transcript := subprocess.NewTranscript(transcriptFile)
+			transcriptI = transcript.Writer(subprocess.TRANSCRIPT_INPUT)
+			transcriptO = transcript.Writer(subprocess.TRANSCRIPT_OUTPUT)
+		}
+
+		topology = subprocess.Interconnected(program, interactor,
+			subprocess.JoinRecorders(fileRecorder(recordI), transcriptI),
+			subprocess.JoinRecorders(fileRecorder(recordO), transcriptO))
+		err = topology.Connect()
 		if err != nil {
 			Fail(globalFlags.Xml, err, "Interconnect")
 		}
+	} else {
+		topology = &subprocess.Topology{Processes: []*subprocess.Subprocess{program}}
 	}
 
-	cs := make(chan RunResult, 1)
-	outstanding := 1
-	if interactor != nil {
-		outstanding++
-		go ExecAndSend(interactor, cs, INTERACTOR)
-	}
-	go ExecAndSend(program, cs, PROGRAM)
-
 	var results [2]*RunResult
+	var repeated *subprocess.RepeatResult
+	if globalFlags.Repeat > 1 {
+		if interactor != nil {
+			Fail(globalFlags.Xml, fmt.Errorf("Can't repeat interactive runs"), "Repeat")
+		}
+		var result *subprocess.SubprocessResult
+		repeated, err = program.Repeat(globalFlags.Repeat, int(globalFlags.RepeatPolicy))
+		if repeated != nil {
+			result = repeated.SubprocessResult
+		}
+		results[PROGRAM] = newRunResult(program, PROGRAM, result, err)
+	} else {
+		subResults, errs := topology.Execute()
+		for i, sub := range topology.Processes {
+			results[i] = newRunResult(sub, ProcessType(i), subResults[i], errs[i])
+		}
+	}
 
 	var programReturnCode int
-
-	for outstanding > 0 {
-		r := <-cs
-		outstanding--
-		results[int(r.T)] = &r
-		if r.T == PROGRAM && r.R != nil {
-			programReturnCode = int(r.R.ExitCode)
-		}
+	if results[PROGRAM].R != nil {
+		programReturnCode = int(results[PROGRAM].R.ExitCode)
 	}
 
 	if globalFlags.Xml {
@@ -325,6 +358,10 @@ func main() {
 		PrintResult(globalFlags.Xml, globalFlags.ShowKernelModeTime, result)
 	}
 
+	if repeated != nil {
+		PrintRepeatStatistics(globalFlags.Xml, repeated)
+	}
+
 	if globalFlags.Xml {
 		fmt.Println(XML_RESULTS_END)
 	}
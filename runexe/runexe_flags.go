@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+
+	"github.com/taskcluster/runlib/subprocess"
 )
 
 type ProcessAffinityFlag uint64
@@ -106,6 +108,32 @@ func (t *EnvFlag) Set(v string) error {
 	return nil
 }
 
+type RepeatPolicyFlag int
+
+func (t *RepeatPolicyFlag) String() string {
+	switch *t {
+	case subprocess.REPEAT_BEST:
+		return "best"
+	case subprocess.REPEAT_MAJORITY:
+		return "majority"
+	}
+	return "worst"
+}
+
+func (t *RepeatPolicyFlag) Set(v string) error {
+	switch strings.ToLower(v) {
+	case "worst":
+		*t = subprocess.REPEAT_WORST
+	case "best":
+		*t = subprocess.REPEAT_BEST
+	case "majority":
+		*t = subprocess.REPEAT_MAJORITY
+	default:
+		return fmt.Errorf("Unknown repeat policy %s", v)
+	}
+	return nil
+}
+
 func PrintUsage() {
 	fmt.Printf("runexe 2.0 version %s build %s\n", version, buildid)
 	fmt.Println(USAGE)
@@ -134,6 +162,12 @@ Global options:
     program and interactor.
   -ri=<f>       - in interactor mode, record program input to file <f>.
   -ro=<f>       - in interactor mode, record program output to file <f>.
+  -transcript=<f> - in interactor mode, record both directions to file <f>
+                  as JSON lines with timestamps. Use transcriptview to read it.
+  -repeat <n>   - run the program <n> times and report min, median and max
+                  of time and memory. Not supported in interactor mode.
+  -repeat-policy=<p> - which run determines the verdict: worst (default),
+                  best or majority.
 
 Process properties:
   -t <value>    - time limit. Terminate after <value> seconds, you can use
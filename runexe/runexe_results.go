@@ -148,6 +148,40 @@ func PrintResult(xml, kernelTime bool, result *RunResult) {
 	}
 }
 
+func printRange(xml bool, name string, r subprocess.Range, format func(uint64) string) {
+	if xml {
+		fmt.Printf("<%s min=\"%s\" median=\"%s\" max=\"%s\"/>\n", name, format(r.Min), format(r.Median), format(r.Max))
+	} else {
+		fmt.Printf("  %-14s %s / %s / %s\n", name+":", format(r.Min), format(r.Median), format(r.Max))
+	}
+}
+
+func microsTime(xml bool) func(uint64) string {
+	return func(v uint64) string {
+		d := time.Duration(v) * time.Microsecond
+		if xml {
+			return xmlTime(d)
+		}
+		return strTime(d)
+	}
+}
+
+func PrintRepeatStatistics(xml bool, r *subprocess.RepeatResult) {
+	if xml {
+		fmt.Printf("<repeatStatistics runs=\"%d\">\n", len(r.Runs))
+		printRange(xml, "processorUserModeTime", r.UserTime, microsTime(xml))
+		printRange(xml, "passedTime", r.WallTime, microsTime(xml))
+		printRange(xml, "consumedMemory", r.PeakMemory, strMemory)
+		fmt.Println("</repeatStatistics>")
+		return
+	}
+	fmt.Println("Repeated", len(r.Runs), "times, min / median / max:")
+	printRange(xml, "time consumed", r.UserTime, microsTime(xml))
+	printRange(xml, "time passed", r.WallTime, microsTime(xml))
+	printRange(xml, "peak memory", r.PeakMemory, strMemory)
+	fmt.Println()
+}
+
 type RunResult struct {
 	V Verdict
 	E error
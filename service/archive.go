@@ -0,0 +1,327 @@
+package service
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/juju/errors"
+	"github.com/taskcluster/runlib/contester_proto"
+)
+
+// Limits for the unpacked contents of archives, both ways.
+const (
+	MAX_ARCHIVE_SIZE  = 256 * 1024 * 1024
+	MAX_ARCHIVE_FILES = 10000
+)
+
+// One entry of the archive being extracted.
+type archiveEntry struct {
+	name  string
+	mode  os.FileMode
+	mtime time.Time
+	open  func() (io.ReadCloser, error)
+}
+
+// Extracts entries into the sandbox directory, keeping track of the limits.
+type extractor struct {
+	root    string
+	sandbox *Sandbox
+	size    int64
+	files   int
+	result  []*contester_proto.FileStat
+}
+
+func (x *extractor) extract(e archiveEntry) error {
+	name := filepath.Clean(filepath.FromSlash(e.name))
+	if name == "." {
+		return nil
+	}
+	if filepath.IsAbs(name) || filepath.VolumeName(name) != "" || name == ".." || strings.HasPrefix(name, ".."+string(os.PathSeparator)) {
+		return errors.BadRequestf("Archive entry %q is outside of the destination", e.name)
+	}
+	if x.files++; x.files > MAX_ARCHIVE_FILES {
+		return errors.BadRequestf("Archive has more than %d entries", MAX_ARCHIVE_FILES)
+	}
+	path, err := secureJoin(x.root, name)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case e.mode.IsDir():
+		return makeDirs(path, x.sandbox)
+	case !e.mode.IsRegular():
+		return errors.BadRequestf("Archive entry %q is not a file or directory", e.name)
+	}
+
+	if err = makeDirs(filepath.Dir(path), x.sandbox); err != nil {
+		return err
+	}
+	r, err := e.open()
+	if err != nil {
+		return errors.Annotatef(err, "Opening %q", e.name)
+	}
+	defer r.Close()
+
+	limited := &io.LimitedReader{R: r, N: MAX_ARCHIVE_SIZE - x.size + 1}
+	// Extracted files stay readable and writable by the owner.
+	stat, err := writeAtomically(path, limited, e.mode.Perm()|0600, &e.mtime, x.sandbox)
+	if err != nil {
+		return err
+	}
+	if x.size = MAX_ARCHIVE_SIZE + 1 - limited.N; x.size > MAX_ARCHIVE_SIZE {
+		os.Remove(path)
+		return errors.BadRequestf("Archive is larger than %d bytes", MAX_ARCHIVE_SIZE)
+	}
+	if stat != nil {
+		x.result = append(x.result, stat)
+	}
+	return nil
+}
+
+func (x *extractor) extractTar(r io.Reader) error {
+	archive := tar.NewReader(r)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.BadRequestf("Reading tar: %s", err)
+		}
+		err = x.extract(archiveEntry{
+			name:  header.Name,
+			mode:  header.FileInfo().Mode(),
+			mtime: header.ModTime,
+			open:  func() (io.ReadCloser, error) { return ioutil.NopCloser(archive), nil },
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (x *extractor) extractZip(data []byte) error {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return errors.BadRequestf("Reading zip: %s", err)
+	}
+	for _, f := range archive.File {
+		err = x.extract(archiveEntry{
+			name:  f.Name,
+			mode:  f.Mode(),
+			mtime: f.Modified,
+			open:  f.Open,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Uncompressed blob data, failing if it is larger than the limit.
+func readArchive(blob *contester_proto.Blob, limit int64) ([]byte, error) {
+	r, err := blob.Reader()
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(r, limit+1))
+	if int64(len(data)) > limit {
+		return nil, errors.BadRequestf("Archive is larger than %d bytes", limit)
+	}
+	if err != nil {
+		return nil, errors.Annotate(err, "Reading archive")
+	}
+	return data, nil
+}
+
+// Extract tar or zip archive into a sandbox directory. Entries can't point outside of it,
+// and only files and directories are allowed.
+func (s *Contester) PutArchive(request *contester_proto.PutArchiveRequest, response *contester_proto.FileStats) error {
+	resolved, sandbox, err := resolvePath(s.Sandboxes, request.GetDestination(), true)
+	if err != nil {
+		return err
+	}
+	sandbox.Mutex.Lock()
+	defer sandbox.Mutex.Unlock()
+	if err = s.checkLease(sandbox, request.GetLease()); err != nil {
+		return err
+	}
+
+	if request.Data == nil {
+		return errors.BadRequestf("No archive data")
+	}
+	if err = makeDirs(resolved, sandbox); err != nil {
+		return err
+	}
+
+	x := extractor{root: resolved, sandbox: sandbox}
+	switch request.GetFormat() {
+	case contester_proto.ArchiveFormat_ZIP:
+		// Zip needs the whole archive in memory, so it is limited before unpacking.
+		var data []byte
+		if data, err = readArchive(request.Data, MAX_ARCHIVE_SIZE); err == nil {
+			err = x.extractZip(data)
+		}
+	default:
+		var r io.Reader
+		if r, err = request.Data.Reader(); err == nil {
+			err = x.extractTar(r)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	response.Entries = x.result
+	return nil
+}
+
+// Writes files into the archive, keeping track of the limits.
+type packer struct {
+	tar   *tar.Writer
+	zip   *zip.Writer
+	size  int64
+	files int
+}
+
+func (p *packer) add(path, name string, info os.FileInfo) error {
+	if !info.IsDir() && !info.Mode().IsRegular() {
+		// Symlinks and special files are skipped.
+		return nil
+	}
+	if p.files++; p.files > MAX_ARCHIVE_FILES {
+		return errors.BadRequestf("More than %d files to pack", MAX_ARCHIVE_FILES)
+	}
+	name = filepath.ToSlash(name)
+	if info.IsDir() {
+		name += "/"
+	} else if p.size += info.Size(); p.size > MAX_ARCHIVE_SIZE {
+		return errors.BadRequestf("More than %d bytes to pack", MAX_ARCHIVE_SIZE)
+	}
+
+	var w io.Writer
+	if p.zip != nil {
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return errors.Annotate(err, "zip.FileInfoHeader")
+		}
+		header.Name = name
+		if !info.IsDir() {
+			header.Method = zip.Deflate
+		}
+		if w, err = p.zip.CreateHeader(header); err != nil {
+			return errors.Annotate(err, "zip.CreateHeader")
+		}
+	} else {
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return errors.Annotate(err, "tar.FileInfoHeader")
+		}
+		header.Name = name
+		header.Uid, header.Gid, header.Uname, header.Gname = 0, 0, "", ""
+		if err = p.tar.WriteHeader(header); err != nil {
+			return errors.Annotate(err, "tar.WriteHeader")
+		}
+		w = p.tar
+	}
+	if info.IsDir() {
+		return nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Annotatef(err, "os.Open(%q)", path)
+	}
+	defer f.Close()
+	// File may have grown since Lstat.
+	if _, err = io.CopyN(w, f, info.Size()); err != nil {
+		return errors.Annotatef(err, "Packing %q", path)
+	}
+	return nil
+}
+
+// Pack the path, recursively if it's a directory, naming entries relative to base.
+func (p *packer) addTree(root, base string) error {
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return errors.Annotate(err, "filepath.Walk")
+		}
+		name, err := filepath.Rel(base, path)
+		if err != nil {
+			return errors.Annotate(err, "filepath.Rel")
+		}
+		if name == "." {
+			return nil
+		}
+		if name == ".." || strings.HasPrefix(name, ".."+string(os.PathSeparator)) {
+			return errors.BadRequestf("%s is outside of %s", path, base)
+		}
+		return p.add(path, name, info)
+	})
+}
+
+func (s *Contester) GetArchive(request *contester_proto.GetArchiveRequest, response *contester_proto.Archive) error {
+	resolved, sandbox, err := resolvePath(s.Sandboxes, request.GetName(), false)
+	if err != nil {
+		return err
+	}
+	if sandbox != nil {
+		sandbox.Mutex.RLock()
+		defer sandbox.Mutex.RUnlock()
+	}
+	if err = s.checkPathLease(resolved, request.GetLease()); err != nil {
+		return err
+	}
+
+	base := resolved
+	matches := []string{resolved}
+	if request.GetExpand() {
+		base = filepath.Dir(resolved)
+		if matches, err = globResolved(s.Sandboxes, resolved, true); err != nil {
+			return err
+		}
+	} else if info, err := os.Stat(resolved); err != nil {
+		return errors.Annotatef(err, "os.Stat(%q)", resolved)
+	} else if !info.IsDir() {
+		base = filepath.Dir(resolved)
+	}
+
+	var buf bytes.Buffer
+	var p packer
+	if request.GetFormat() == contester_proto.ArchiveFormat_ZIP {
+		p.zip = zip.NewWriter(&buf)
+	} else {
+		p.tar = tar.NewWriter(&buf)
+	}
+
+	for _, match := range matches {
+		if err = p.addTree(match, base); err != nil {
+			return err
+		}
+	}
+
+	if p.zip != nil {
+		err = p.zip.Close()
+	} else {
+		err = p.tar.Close()
+	}
+	if err != nil {
+		return errors.Annotate(err, "Closing archive")
+	}
+
+	if response.Data, err = contester_proto.NewBlob(buf.Bytes()); err != nil {
+		return err
+	}
+	response.Format = request.Format
+	response.Files = proto.Uint32(uint32(p.files))
+	return nil
+}
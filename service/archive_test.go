@@ -0,0 +1,137 @@
+// +build linux
+
+package service
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/juju/errors"
+	"github.com/taskcluster/runlib/contester_proto"
+)
+
+type tarEntry struct {
+	name, link string
+	flag       byte
+	data       string
+}
+
+func makeTar(t *testing.T, entries ...tarEntry) *contester_proto.Blob {
+	var buf bytes.Buffer
+	w := tar.NewWriter(&buf)
+	for _, e := range entries {
+		header := &tar.Header{Name: e.name, Typeflag: e.flag, Linkname: e.link, Mode: 0644, Size: int64(len(e.data))}
+		if e.flag == tar.TypeDir || e.flag == tar.TypeSymlink {
+			header.Size = 0
+		}
+		if err := w.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(e.data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	blob, err := contester_proto.NewBlob(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return blob
+}
+
+func TestPutGetArchive(t *testing.T) {
+	c, _, cleanup := newTestContester(t, nil)
+	defer cleanup()
+
+	var stats contester_proto.FileStats
+	err := c.PutArchive(&contester_proto.PutArchiveRequest{
+		Destination: proto.String("%0.C/src"),
+		Data: makeTar(t,
+			tarEntry{name: "pkg/", flag: tar.TypeDir},
+			tarEntry{name: "pkg/Main.java", flag: tar.TypeReg, data: "class Main {}"},
+			tarEntry{name: "./README", flag: tar.TypeReg, data: "readme"}),
+	}, &stats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats.Entries) != 2 {
+		t.Errorf("Expected 2 extracted files, got %v", &stats)
+	}
+	root := filepath.Join(c.Sandboxes[0].Compile.Path, "src")
+	if data, err := ioutil.ReadFile(filepath.Join(root, "pkg", "Main.java")); err != nil || string(data) != "class Main {}" {
+		t.Errorf("Extracted %q (%v)", data, err)
+	}
+
+	for _, bad := range [][]tarEntry{
+		{{name: "../escape", flag: tar.TypeReg, data: "x"}},
+		{{name: "/etc/escape", flag: tar.TypeReg, data: "x"}},
+		{{name: "link", flag: tar.TypeSymlink, link: "/etc"}},
+	} {
+		err = c.PutArchive(&contester_proto.PutArchiveRequest{
+			Destination: proto.String("%0.C/src"),
+			Data:        makeTar(t, bad...),
+		}, &stats)
+		if !errors.IsBadRequest(err) {
+			t.Errorf("Expected bad request for %v, got %v", bad, err)
+		}
+	}
+
+	// Symlink planted by the submission can't be used to escape.
+	if err = os.Symlink("/etc", filepath.Join(root, "planted")); err != nil {
+		t.Fatal(err)
+	}
+	err = c.PutArchive(&contester_proto.PutArchiveRequest{
+		Destination: proto.String("%0.C/src"),
+		Data:        makeTar(t, tarEntry{name: "planted/escape", flag: tar.TypeReg, data: "x"}),
+	}, &stats)
+	if !errors.IsBadRequest(err) {
+		t.Errorf("Expected bad request for planted symlink, got %v", err)
+	}
+
+	for _, format := range []contester_proto.ArchiveFormat{contester_proto.ArchiveFormat_TAR, contester_proto.ArchiveFormat_ZIP} {
+		var archive contester_proto.Archive
+		if err = c.GetArchive(&contester_proto.GetArchiveRequest{Name: proto.String("%0.C/src"), Format: format.Enum()}, &archive); err != nil {
+			t.Fatal(err)
+		}
+		// pkg, pkg/Main.java and README, the symlink is skipped.
+		if archive.GetFiles() != 3 {
+			t.Errorf("Expected 3 entries in %s archive, got %d", format, archive.GetFiles())
+		}
+
+		err = c.PutArchive(&contester_proto.PutArchiveRequest{
+			Destination: proto.String("%1.R"),
+			Data:        archive.Data,
+			Format:      format.Enum(),
+		}, &stats)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data, err := ioutil.ReadFile(filepath.Join(c.Sandboxes[1].Run.Path, "pkg", "Main.java")); err != nil || string(data) != "class Main {}" {
+			t.Errorf("Unpacked %q from %s archive (%v)", data, format, err)
+		}
+	}
+}
+
+func TestReadArchiveLimit(t *testing.T) {
+	// Compresses to a few kilobytes.
+	blob, err := contester_proto.NewBlob(make([]byte, 1024*1024))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blob.Compression == nil || len(blob.Data) > 64*1024 {
+		t.Fatalf("Blob is not compressed: %d bytes", len(blob.Data))
+	}
+	if _, err = readArchive(blob, 1000); !errors.IsBadRequest(err) {
+		t.Errorf("Expected bad request for oversized archive, got %v", err)
+	}
+	if data, err := readArchive(blob, 1024*1024); err != nil || len(data) != 1024*1024 {
+		t.Errorf("Read %d bytes (%v)", len(data), err)
+	}
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/taskcluster/runlib/contester_proto"
+	"github.com/taskcluster/runlib/subprocess"
+)
+
+// How long to wait for an external tool to attach to a FIFO or socket redirect.
+const ATTACH_TIMEOUT = 30 * time.Second
+
+// FIFOs and sockets created in the sandbox for external tools to attach to.
+type attachments struct {
+	cleanup []func()
+}
+
+func (a *attachments) Close() {
+	for i := len(a.cleanup) - 1; i >= 0; i-- {
+		a.cleanup[i]()
+	}
+	a.cleanup = nil
+}
+
+func (a *attachments) onClose(f func()) {
+	a.cleanup = append(a.cleanup, f)
+}
+
+func resolveAttachPath(s []SandboxPair, sandbox *Sandbox, name string) (string, error) {
+	path, _, err := resolvePath(s, name, false)
+	if err != nil {
+		return "", err
+	}
+	path = filepath.Clean(path)
+	if owner, err := getSandboxByPath(s, path); err != nil || owner != sandbox || path == sandbox.Path {
+		return "", errors.BadRequestf("Attach point %s is not inside sandbox %s", name, sandbox.Path)
+	}
+	return path, nil
+}
+
+// Create FIFO and socket redirects requested for stdin and stdout, and wait for external tools
+// to attach to them. Socket, named by both stdin and stdout, is shared. Returned attachments
+// must be closed after the process finishes.
+func (s *Contester) attachRedirects(request *contester_proto.LocalExecutionParameters, sandbox *Sandbox, sub *subprocess.Subprocess) (*attachments, error) {
+	a := &attachments{}
+	sockets := make(map[string]*os.File)
+
+	for _, v := range []struct {
+		params   *contester_proto.RedirectParameters
+		redirect **subprocess.Redirect
+		input    bool
+	}{
+		{request.StdIn, &sub.StdIn, true},
+		{request.StdOut, &sub.StdOut, false},
+	} {
+		if v.params == nil || (v.params.Fifo == nil && v.params.Socket == nil) {
+			continue
+		}
+
+		// The redirect is replaced, so it must not hold anything else.
+		if v.params.Filename != nil || v.params.Buffer != nil || v.params.GetMemory() || v.params.GetPty() || v.params.GetTee() ||
+			(v.params.Fifo != nil && v.params.Socket != nil) {
+			a.Close()
+			return nil, errors.BadRequestf("Fifo or socket redirect can't be combined with other redirect parameters")
+		}
+
+		var f *os.File
+		var err error
+		if v.params.Fifo != nil {
+			var path string
+			if path, err = resolveAttachPath(s.Sandboxes, sandbox, v.params.GetFifo()); err == nil {
+				if f, err = a.attachFifo(path, v.input); err == nil {
+					a.onClose(func() { f.Close() })
+				}
+			}
+		} else {
+			var path string
+			if path, err = resolveAttachPath(s.Sandboxes, sandbox, v.params.GetSocket()); err == nil {
+				if f = sockets[path]; f == nil {
+					if f, err = a.attachSocket(path); err == nil {
+						sockets[path] = f
+						a.onClose(func() { f.Close() })
+					}
+				}
+			}
+		}
+		if err != nil {
+			a.Close()
+			return nil, err
+		}
+
+		*v.redirect = &subprocess.Redirect{
+			Mode: subprocess.REDIRECT_PIPE,
+			Pipe: f,
+		}
+	}
+	return a, nil
+}
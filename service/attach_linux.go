@@ -0,0 +1,71 @@
+package service
+
+import (
+	"net"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+type openResult struct {
+	f   *os.File
+	err error
+}
+
+// Create a FIFO and open our end of it, which blocks until the peer opens the other one.
+func (a *attachments) attachFifo(path string, input bool) (*os.File, error) {
+	if err := syscall.Mkfifo(path, 0600); err != nil {
+		return nil, errors.Annotate(os.NewSyscallError("mkfifo", err), path)
+	}
+	a.onClose(func() { os.Remove(path) })
+
+	flag, other := os.O_RDONLY, os.O_WRONLY
+	if !input {
+		flag, other = other, flag
+	}
+
+	c := make(chan openResult, 1)
+	go func() {
+		f, err := os.OpenFile(path, flag, 0)
+		c <- openResult{f, err}
+	}()
+
+	select {
+	case r := <-c:
+		return r.f, errors.Trace(r.err)
+	case <-time.After(ATTACH_TIMEOUT):
+	}
+
+	// Opening the other end unblocks the pending open.
+	if u, err := os.OpenFile(path, other|syscall.O_NONBLOCK, 0); err == nil {
+		if r := <-c; r.f != nil {
+			r.f.Close()
+		}
+		u.Close()
+	}
+	return nil, errors.Timeoutf("Nobody attached to %s", path)
+}
+
+// Listen on the unix socket and accept exactly one connection.
+func (a *attachments) attachSocket(path string) (*os.File, error) {
+	l, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	if err != nil {
+		return nil, errors.Annotate(err, path)
+	}
+	defer l.Close() // also removes the socket file
+
+	l.SetDeadline(time.Now().Add(ATTACH_TIMEOUT))
+	conn, err := l.AcceptUnix()
+	if err != nil {
+		if ne, ok := err.(net.Error); ok && ne.Timeout() {
+			return nil, errors.Timeoutf("Nobody attached to %s", path)
+		}
+		return nil, errors.Annotate(err, path)
+	}
+	defer conn.Close()
+
+	f, err := conn.File()
+	return f, errors.Trace(err)
+}
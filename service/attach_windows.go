@@ -0,0 +1,15 @@
+package service
+
+import (
+	"os"
+
+	"github.com/juju/errors"
+)
+
+func (a *attachments) attachFifo(path string, input bool) (*os.File, error) {
+	return nil, errors.NotSupportedf("FIFO redirects")
+}
+
+func (a *attachments) attachSocket(path string) (*os.File, error) {
+	return nil, errors.NotSupportedf("Unix socket redirects")
+}
@@ -0,0 +1,303 @@
+package service
+
+import (
+	"container/list"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/golang/protobuf/proto"
+	"github.com/juju/errors"
+	"github.com/taskcluster/runlib/contester_proto"
+	"github.com/taskcluster/runlib/storage"
+	"github.com/taskcluster/runlib/tools"
+)
+
+// Size of the download cache, if the config doesn't set it.
+const DEFAULT_CACHE_MB = 1024
+
+type cacheEntry struct {
+	key  string // algo:hex
+	path string
+	size int64
+	refs int // entries in use are never evicted
+	elem *list.Element
+}
+
+// Storage downloads keyed by checksum, shared by all sandboxes. Files are stored as
+// Dir/algo/hex, read-only, and evicted least recently used first.
+type downloadCache struct {
+	Dir       string
+	MaxBytes  int64
+	Hardlinks bool // link hits if reflinks aren't supported, instead of copying
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	lru     *list.List // most recently used at front
+	size    int64
+
+	hits, misses, evictions uint64
+}
+
+// Open the cache directory, picking up entries left from the previous start.
+func newDownloadCache(dir string, maxBytes int64, hardlinks bool) (*downloadCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Annotatef(err, "os.MkdirAll(%q)", dir)
+	}
+	c := &downloadCache{
+		Dir:       dir,
+		MaxBytes:  maxBytes,
+		Hardlinks: hardlinks,
+		entries:   make(map[string]*cacheEntry),
+		lru:       list.New(),
+	}
+
+	type cachedFile struct {
+		key  string
+		info os.FileInfo
+	}
+	var found []cachedFile
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if algo, sum, err := contester_proto.ParseChecksum(filepath.Dir(rel) + ":" + info.Name()); err == nil {
+			found = append(found, cachedFile{contester_proto.FormatChecksum(algo, sum), info})
+		} else {
+			// Interrupted downloads.
+			os.Remove(path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotatef(err, "Scanning %s", dir)
+	}
+	// Oldest go to the back, to be evicted first.
+	sort.Slice(found, func(i, j int) bool {
+		return found[i].info.ModTime().After(found[j].info.ModTime())
+	})
+	for _, f := range found {
+		c.add(f.key, f.info.Size())
+	}
+	c.evict()
+	return c, nil
+}
+
+func (c *downloadCache) path(key string) string {
+	return filepath.Join(c.Dir, strings.Replace(key, ":", string(os.PathSeparator), 1))
+}
+
+// Append the entry at the back of the LRU. Must be called with c.mu held.
+func (c *downloadCache) add(key string, size int64) *cacheEntry {
+	e := &cacheEntry{key: key, path: c.path(key), size: size}
+	e.elem = c.lru.PushBack(e)
+	c.entries[key] = e
+	c.size += size
+	return e
+}
+
+// Drop least recently used entries until the cache fits. Must be called with c.mu held.
+func (c *downloadCache) evict() {
+	for elem := c.lru.Back(); elem != nil && c.size > c.MaxBytes; {
+		prev := elem.Prev()
+		if e := elem.Value.(*cacheEntry); e.refs == 0 {
+			if err := os.Remove(e.path); err != nil && !os.IsNotExist(err) {
+				log.Errorf("Evicting %s: %s", e.path, err)
+			}
+			c.lru.Remove(elem)
+			delete(c.entries, e.key)
+			c.size -= e.size
+			c.evictions++
+		}
+		elem = prev
+	}
+}
+
+// Regular file with other links, possibly shared with the cache. Such files are never
+// given to the sandbox owner or made writable.
+func isHardlinked(info os.FileInfo) bool {
+	return info.Mode().IsRegular() && linkCount(info) > 1
+}
+
+// Find and pin the entry, nil if it isn't cached.
+func (c *downloadCache) acquire(key string) *cacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e := c.entries[key]
+	if e == nil {
+		c.misses++
+		return nil
+	}
+	c.hits++
+	e.refs++
+	c.lru.MoveToFront(e.elem)
+	return e
+}
+
+func (c *downloadCache) release(e *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e.refs--
+	c.evict()
+}
+
+// Hardlinked entries could have been changed through one of the links, so they are
+// checked against the key again.
+func (c *downloadCache) intact(e *cacheEntry) bool {
+	info, err := os.Lstat(e.path)
+	if err != nil {
+		return false
+	}
+	if !isHardlinked(info) {
+		return true
+	}
+	checksum, err := tools.ChecksumFile(e.path, strings.SplitN(e.key, ":", 2)[0])
+	return err == nil && checksum == e.key
+}
+
+// Download the object into the cache, checking it against the key, and return the
+// pinned entry.
+func (c *downloadCache) fetch(backend storage.Backend, key string, item *contester_proto.CopyOperation) (*cacheEntry, error) {
+	temp, err := ioutil.TempFile(c.Dir, ".fetch")
+	if err != nil {
+		return nil, errors.Annotate(err, "ioutil.TempFile")
+	}
+	tempName := temp.Name()
+	temp.Close()
+	defer os.Remove(tempName)
+
+	stat, err := backend.Copy(tempName, item.GetRemoteLocation(), false, key, "", item.GetAuthorizationToken())
+	if err != nil {
+		return nil, err
+	}
+	if stat == nil || stat.GetChecksum() != key {
+		return nil, errors.NotValidf("Downloaded %s has checksum %q, expected %q", item.GetRemoteLocation(), stat.GetChecksum(), key)
+	}
+	if err = os.Chmod(tempName, 0444); err != nil {
+		return nil, errors.Annotate(err, "os.Chmod")
+	}
+
+	path := c.path(key)
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, errors.Annotate(err, "os.MkdirAll")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Same contents if someone else has fetched it meanwhile.
+	if err = os.Rename(tempName, path); err != nil {
+		return nil, errors.Annotatef(err, "os.Rename(%q)", path)
+	}
+	e := c.entries[key]
+	if e == nil {
+		e = c.add(key, int64(stat.GetSize_()))
+		c.lru.MoveToFront(e.elem)
+	}
+	e.refs++
+	c.evict()
+	return e, nil
+}
+
+// Place the cached file at the destination: reflinked if the filesystem supports it,
+// hardlinked if configured and on the same filesystem (such files stay read-only and
+// aren't given to the sandbox owner), copied otherwise.
+func (c *downloadCache) place(source, destination string, sandbox *Sandbox) error {
+	temp, err := ioutil.TempFile(filepath.Dir(destination), ".cache")
+	if err != nil {
+		return errors.Annotatef(err, "ioutil.TempFile(%q)", destination)
+	}
+	tempName := temp.Name()
+	defer os.Remove(tempName)
+
+	err = reflink(source, temp)
+	temp.Close()
+	switch {
+	case err == nil:
+		err = os.Chmod(tempName, DEFAULT_PUT_MODE)
+		if err == nil && sandbox != nil {
+			err = sandbox.Own(tempName)
+		}
+		if err != nil {
+			return errors.Annotate(err, "Preparing reflinked file")
+		}
+	case c.Hardlinks && c.link(source, tempName):
+		// Stays read-only and owned by the service.
+	default:
+		src, err := os.Open(source)
+		if err != nil {
+			return errors.Annotatef(err, "os.Open(%q)", source)
+		}
+		defer src.Close()
+		_, err = writeAtomically(destination, src, DEFAULT_PUT_MODE, nil, sandbox)
+		return err
+	}
+
+	if err = os.Rename(tempName, destination); err != nil {
+		return errors.Annotatef(err, "os.Rename(%q)", destination)
+	}
+	return nil
+}
+
+// Hardlink the cached file over the temporary one. Sandboxes on other filesystems, like
+// quota mounts or overlays, can't be linked to and get copies instead.
+func (c *downloadCache) link(source, tempName string) bool {
+	os.Remove(tempName)
+	if err := os.Link(source, tempName); err != nil {
+		log.Debugf("Copying %s instead of linking: %s", source, err)
+		return false
+	}
+	return true
+}
+
+// Download through the cache. The checksum of the item is the key.
+func (c *downloadCache) download(backend storage.Backend, destination string, item *contester_proto.CopyOperation, sandbox *Sandbox) (*contester_proto.FileStat, error) {
+	algo, sum, err := contester_proto.ParseChecksum(item.GetChecksum())
+	if err != nil {
+		return nil, err
+	}
+	key := contester_proto.FormatChecksum(algo, sum)
+
+	e := c.acquire(key)
+	if e != nil && !c.intact(e) {
+		log.Errorf("Cached %s was modified, downloading it again", e.path)
+		c.release(e)
+		e = nil
+	}
+	if e == nil {
+		if e, err = c.fetch(backend, key, item); err != nil {
+			return nil, err
+		}
+	}
+	defer c.release(e)
+
+	if err = c.place(e.path, destination, sandbox); err != nil {
+		return nil, err
+	}
+	stat, err := tools.StatFile(destination, "")
+	if err != nil || stat == nil {
+		return stat, err
+	}
+	// Verified when it was put into the cache.
+	stat.Checksum = proto.String(key)
+	return stat, nil
+}
+
+func (c *downloadCache) stats() *contester_proto.DownloadCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return &contester_proto.DownloadCacheStats{
+		Hits:      proto.Uint64(c.hits),
+		Misses:    proto.Uint64(c.misses),
+		Evictions: proto.Uint64(c.evictions),
+		Entries:   proto.Uint64(uint64(len(c.entries))),
+		UsedBytes: proto.Uint64(uint64(c.size)),
+		MaxBytes:  proto.Uint64(uint64(c.MaxBytes)),
+	}
+}
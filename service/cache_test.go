@@ -0,0 +1,249 @@
+// +build linux
+
+package service
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/taskcluster/runlib/contester_proto"
+	"github.com/taskcluster/runlib/tools"
+)
+
+// Serves objects from memory, counting downloads.
+type fakeBackend struct {
+	objects   map[string][]byte
+	downloads int
+}
+
+func (b *fakeBackend) String() string { return "fake" }
+func (b *fakeBackend) Close()         {}
+
+func (b *fakeBackend) Copy(localName, remoteName string, toRemote bool, checksum, moduleType, authToken string) (*contester_proto.FileStat, error) {
+	b.downloads++
+	if err := ioutil.WriteFile(localName, b.objects[remoteName], 0644); err != nil {
+		return nil, err
+	}
+	algo, _, err := contester_proto.ParseChecksum(checksum)
+	if err != nil {
+		return nil, err
+	}
+	return tools.StatFile(localName, algo)
+}
+
+func sha256Of(t *testing.T, data []byte) string {
+	sum, err := contester_proto.CalcChecksum(contester_proto.CHECKSUM_SHA256, bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sum
+}
+
+func TestDownloadCache(t *testing.T) {
+	c, _, cleanup := newTestContester(t, nil)
+	defer cleanup()
+
+	dir, err := ioutil.TempDir("", "cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	backend := &fakeBackend{objects: map[string][]byte{
+		"test/1": []byte("first input"),
+		"test/2": []byte("second input"),
+		"test/3": []byte("third input"),
+	}}
+	c.Storage = backend
+	if c.DownloadCache, err = newDownloadCache(dir, 25, false); err != nil {
+		t.Fatal(err)
+	}
+
+	download := func(remote, local, checksum string) *contester_proto.FileStats {
+		var stats contester_proto.FileStats
+		err := c.GridfsCopy(&contester_proto.CopyOperations{
+			Entries: []*contester_proto.CopyOperation{{
+				LocalFileName:  proto.String(local),
+				RemoteLocation: proto.String(remote),
+				Checksum:       proto.String(checksum),
+			}},
+		}, &stats)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &stats
+	}
+
+	first := sha256Of(t, backend.objects["test/1"])
+	for i := range c.Sandboxes {
+		stats := download("test/1", c.Sandboxes[i].Run.Path+"/input.txt", first)
+		if len(stats.Entries) != 1 || stats.Entries[0].GetChecksum() != first {
+			t.Errorf("Unexpected download result %v", stats)
+		}
+		if data, err := ioutil.ReadFile(filepath.Join(c.Sandboxes[i].Run.Path, "input.txt")); err != nil || string(data) != "first input" {
+			t.Errorf("Downloaded %q (%v)", data, err)
+		}
+	}
+	if backend.downloads != 1 {
+		t.Errorf("Expected one download, got %d", backend.downloads)
+	}
+	if stats := c.DownloadCache.stats(); stats.GetHits() != 1 || stats.GetMisses() != 1 || stats.GetEntries() != 1 {
+		t.Errorf("Unexpected cache stats %v", stats)
+	}
+
+	// Corrupt object is neither placed nor cached.
+	if stats := download("test/2", c.Sandboxes[0].Run.Path+"/bad.txt", first[:len(first)-2]+"00"); len(stats.Entries) != 0 {
+		t.Errorf("Corrupt download succeeded: %v", stats)
+	}
+	if _, err = os.Stat(filepath.Join(c.Sandboxes[0].Run.Path, "bad.txt")); !os.IsNotExist(err) {
+		t.Errorf("Corrupt file was placed: %v", err)
+	}
+
+	download("test/2", c.Sandboxes[0].Run.Path+"/2.txt", sha256Of(t, backend.objects["test/2"]))
+	download("test/3", c.Sandboxes[0].Run.Path+"/3.txt", sha256Of(t, backend.objects["test/3"]))
+	stats := c.DownloadCache.stats()
+	if stats.GetEvictions() == 0 || stats.GetUsedBytes() > 25 {
+		t.Errorf("Expected eviction, got %v", stats)
+	}
+
+	// Cache is picked up on restart.
+	if c.DownloadCache, err = newDownloadCache(dir, 25, true); err != nil {
+		t.Fatal(err)
+	}
+	if restarted := c.DownloadCache.stats(); restarted.GetEntries() != stats.GetEntries() || restarted.GetUsedBytes() != stats.GetUsedBytes() {
+		t.Errorf("Expected %v after restart, got %v", stats, restarted)
+	}
+	downloads := backend.downloads
+	download("test/3", c.Sandboxes[1].Run.Path+"/3.txt", sha256Of(t, backend.objects["test/3"]))
+	if backend.downloads != downloads {
+		t.Errorf("Cached object was downloaded again")
+	}
+	if data, err := ioutil.ReadFile(filepath.Join(c.Sandboxes[1].Run.Path, "3.txt")); err != nil || string(data) != "third input" {
+		t.Errorf("Hardlinked %q (%v)", data, err)
+	}
+}
+
+func TestDownloadCacheHardlinks(t *testing.T) {
+	c, _, cleanup := newTestContester(t, nil)
+	defer cleanup()
+
+	dir, err := ioutil.TempDir("", "cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	backend := &fakeBackend{objects: map[string][]byte{"test/1": []byte("first input")}}
+	c.Storage = backend
+	if c.DownloadCache, err = newDownloadCache(dir, 1024, true); err != nil {
+		t.Fatal(err)
+	}
+	checksum := sha256Of(t, backend.objects["test/1"])
+	download := func(local string) {
+		err := c.GridfsCopy(&contester_proto.CopyOperations{
+			Entries: []*contester_proto.CopyOperation{{
+				LocalFileName:  proto.String(local),
+				RemoteLocation: proto.String("test/1"),
+				Checksum:       proto.String(checksum),
+			}},
+		}, &contester_proto.FileStats{})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	first := filepath.Join(c.Sandboxes[0].Run.Path, "input.txt")
+	download(first)
+	info, err := os.Stat(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isHardlinked(info) {
+		t.Skip("Cache hits are reflinked on this filesystem")
+	}
+
+	// The sandbox gets its own copy to make executable.
+	if err = chmodIfNeeded(first, &c.Sandboxes[0].Run); err != nil {
+		t.Fatal(err)
+	}
+	if info, err = os.Stat(first); err != nil || isHardlinked(info) || info.Mode()&0100 == 0 {
+		t.Errorf("Unexpected executable %v (%v)", info, err)
+	}
+	cached := c.DownloadCache.path(checksum)
+	if info, err = os.Stat(cached); err != nil || info.Mode().Perm() != 0444 {
+		t.Errorf("Cached file changed: %v (%v)", info, err)
+	}
+
+	// Changed through another link, the entry is downloaded again.
+	second := filepath.Join(c.Sandboxes[1].Run.Path, "input.txt")
+	download(second)
+	if err = os.Chmod(second, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(second, []byte("changed"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	third := filepath.Join(c.Sandboxes[1].Run.Path, "again.txt")
+	download(third)
+	if backend.downloads != 2 {
+		t.Errorf("Expected the modified entry to be downloaded again, got %d downloads", backend.downloads)
+	}
+	if data, err := ioutil.ReadFile(third); err != nil || string(data) != "first input" {
+		t.Errorf("Downloaded %q (%v)", data, err)
+	}
+}
+
+func deviceOf(t *testing.T, path string) uint64 {
+	var st syscall.Stat_t
+	if err := syscall.Stat(path, &st); err != nil {
+		t.Fatal(err)
+	}
+	return uint64(st.Dev)
+}
+
+func TestDownloadCacheHardlinksAcrossFilesystems(t *testing.T) {
+	c, _, cleanup := newTestContester(t, nil)
+	defer cleanup()
+
+	dir, err := ioutil.TempDir("/dev/shm", "cache")
+	if err != nil {
+		t.Skip("No tmpfs for the cache: ", err)
+	}
+	defer os.RemoveAll(dir)
+	if deviceOf(t, dir) == deviceOf(t, c.Sandboxes[0].Run.Path) {
+		t.Skip("Cache and sandboxes are on the same filesystem")
+	}
+
+	backend := &fakeBackend{objects: map[string][]byte{"test/1": []byte("first input")}}
+	c.Storage = backend
+	if c.DownloadCache, err = newDownloadCache(dir, 1024, true); err != nil {
+		t.Fatal(err)
+	}
+	local := filepath.Join(c.Sandboxes[0].Run.Path, "input.txt")
+	var stats contester_proto.FileStats
+	err = c.GridfsCopy(&contester_proto.CopyOperations{
+		Entries: []*contester_proto.CopyOperation{{
+			LocalFileName:  proto.String(local),
+			RemoteLocation: proto.String("test/1"),
+			Checksum:       proto.String(sha256Of(t, backend.objects["test/1"])),
+		}},
+	}, &stats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats.Entries) != 1 {
+		t.Fatalf("Download across filesystems failed: %v", &stats)
+	}
+	info, err := os.Stat(local)
+	if err != nil || isHardlinked(info) || info.Mode().Perm() != DEFAULT_PUT_MODE {
+		t.Errorf("Expected a copy, got %v (%v)", info, err)
+	}
+	if data, err := ioutil.ReadFile(local); err != nil || string(data) != "first input" {
+		t.Errorf("Copied %q (%v)", data, err)
+	}
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/golang/protobuf/proto"
+	"github.com/juju/errors"
+	"github.com/taskcluster/runlib/contester_proto"
+	"github.com/taskcluster/runlib/subprocess"
+)
+
+// Binaries which call service.NewContester must check for this argument first and
+// run CalibrationWorkload instead of the server.
+const CALIBRATION_ARG = "--calibration-workload"
+
+const (
+	CALIBRATION_ITERATIONS = 1 << 28
+	CALIBRATION_RUNS       = 3
+
+	// User time of the workload on the reference host, which has speed factor 1.
+	CALIBRATION_REFERENCE = time.Second
+
+	// Prefix of the copy of the service binary in the sandbox.
+	CALIBRATION_BINARY_PREFIX = ".calibration-"
+)
+
+// Fixed amount of integer and cache-bound work. Result is printed so that nothing is optimized away.
+func CalibrationWorkload() {
+	var table [1 << 16]uint32
+	x := uint64(88172645463325252)
+	for i := 0; i < CALIBRATION_ITERATIONS; i++ {
+		x ^= x << 13
+		x ^= x >> 7
+		x ^= x << 17
+		table[x&0xffff] += uint32(x >> 32)
+	}
+	var sum uint32
+	for _, v := range table {
+		sum ^= v
+	}
+	fmt.Println(sum)
+}
+
+// Run the workload and update the host speed factor, or remember why it failed.
+func (s *Contester) calibrate() (float64, error) {
+	factor, err := s.runCalibration()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		s.calibrationError = err.Error()
+		return 0, err
+	}
+	s.speedFactor, s.calibrationError = factor, ""
+	return factor, nil
+}
+
+// Run the workload through the normal subprocess path in the first run sandbox, and
+// return the speed factor by the best of several runs.
+func (s *Contester) runCalibration() (float64, error) {
+	if len(s.Sandboxes) == 0 {
+		return 0, errors.New("No sandboxes to calibrate in")
+	}
+	self, err := os.Executable()
+	if err != nil {
+		return 0, errors.Annotate(err, "os.Executable")
+	}
+
+	sandbox := &s.Sandboxes[0].Run
+	sandbox.Mutex.Lock()
+	defer sandbox.Mutex.Unlock()
+	if err = s.checkLease(sandbox, ""); err != nil {
+		return 0, err
+	}
+
+	// The sandbox user may not be able to read the service binary, so it runs a copy.
+	workload := filepath.Join(sandbox.Path, CALIBRATION_BINARY_PREFIX+filepath.Base(self))
+	if _, err = copyFile(self, workload, sandbox); err != nil {
+		return 0, err
+	}
+	defer os.Remove(workload)
+
+	request := &contester_proto.LocalExecutionParameters{
+		ApplicationName:       proto.String(workload),
+		CommandLineParameters: []string{workload, CALIBRATION_ARG},
+		CurrentDirectory:      proto.String(sandbox.Path),
+		TimeLimitMicros:       proto.Uint64(subprocess.GetMicros(60 * CALIBRATION_REFERENCE)),
+		MemoryLimit:           proto.Uint64(256 * 1024 * 1024),
+	}
+	sub, err := s.setupSubprocess(request, sandbox, true)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := subprocess.ExecuteRepeated(s.Executor, sub, CALIBRATION_RUNS, subprocess.REPEAT_BEST)
+	if err != nil {
+		return 0, err
+	}
+	if result.SuccessCode != 0 || result.ExitCode != 0 || result.UserTime.Min == 0 {
+		return 0, errors.Errorf("Calibration workload failed: success code %d, exit code %d", result.SuccessCode, result.ExitCode)
+	}
+
+	factor := float64(subprocess.GetMicros(CALIBRATION_REFERENCE)) / float64(result.UserTime.Min)
+	log.Infof("Calibrated host speed factor %f (%d us)", factor, result.UserTime.Min)
+	return factor, nil
+}
+
+// Host speed relative to the reference one, 0 if not calibrated.
+func (s *Contester) getSpeedFactor() float64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.speedFactor
+}
+
+// Why the last calibration failed, empty if it succeeded.
+func (s *Contester) getCalibrationError() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.calibrationError
+}
+
+func (s *Contester) Calibrate(request *contester_proto.CalibrateRequest, response *contester_proto.CalibrateResponse) error {
+	factor, err := s.calibrate()
+	if err != nil {
+		return err
+	}
+	response.SpeedFactor = proto.Float64(factor)
+	return nil
+}
+
+// Convert reference time limit to this host's one.
+func scaleLimit(limit time.Duration, factor float64) time.Duration {
+	if factor <= 0 {
+		return limit
+	}
+	return time.Duration(float64(limit) / factor)
+}
+
+// Report time converted to the reference host, if the request asked for normalisation.
+func (s *Contester) fillNormalizedTime(request *contester_proto.LocalExecutionParameters, r *subprocess.SubprocessResult, response *contester_proto.LocalExecutionResult) {
+	if !request.GetNormalizeTime() {
+		return
+	}
+	if factor := s.getSpeedFactor(); factor > 0 {
+		response.NormalizedTime = normalizeTime(r, factor)
+	}
+}
+
+// Convert time measured on this host to the reference one.
+func normalizeTime(r *subprocess.SubprocessResult, factor float64) *contester_proto.ExecutionResultTime {
+	scaled := *r
+	scaled.UserTime = time.Duration(float64(r.UserTime) * factor)
+	scaled.KernelTime = time.Duration(float64(r.KernelTime) * factor)
+	scaled.WallTime = time.Duration(float64(r.WallTime) * factor)
+	return parseTime(&scaled)
+}
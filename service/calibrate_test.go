@@ -0,0 +1,48 @@
+// +build linux
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/taskcluster/runlib/subprocess"
+)
+
+func TestCalibrate(t *testing.T) {
+	exitCode := uint32(0)
+	c, executor, cleanup := newTestContester(t, func(sub *subprocess.Subprocess) (*subprocess.SubprocessResult, error) {
+		if _, err := os.Stat(*sub.Cmd.ApplicationName); err != nil {
+			return nil, err
+		}
+		r := &subprocess.SubprocessResult{ExitCode: exitCode}
+		r.UserTime = CALIBRATION_REFERENCE / 2
+		return r, nil
+	})
+	defer cleanup()
+
+	factor, err := c.calibrate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if factor != 2 || c.getSpeedFactor() != 2 || c.getCalibrationError() != "" {
+		t.Errorf("Unexpected speed factor %f (%f, %q)", factor, c.getSpeedFactor(), c.getCalibrationError())
+	}
+	executed := executor.Executed()
+	if len(executed) != CALIBRATION_RUNS || filepath.Dir(*executed[0].Cmd.ApplicationName) != c.Sandboxes[0].Run.Path {
+		t.Fatalf("Workload is not run from the sandbox: %d runs of %s", len(executed), *executed[0].Cmd.ApplicationName)
+	}
+	if _, err = os.Stat(*executed[0].Cmd.ApplicationName); !os.IsNotExist(err) {
+		t.Errorf("Workload copy is left in the sandbox: %v", err)
+	}
+
+	// Failure keeps the previous factor, and is reported.
+	exitCode = 1
+	if _, err = c.calibrate(); err == nil {
+		t.Fatal("Failed calibration succeeded")
+	}
+	if c.getSpeedFactor() != 2 || c.getCalibrationError() == "" {
+		t.Errorf("Unexpected state after failure: %f, %q", c.getSpeedFactor(), c.getCalibrationError())
+	}
+}
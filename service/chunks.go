@@ -0,0 +1,261 @@
+package service
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/juju/errors"
+	"github.com/taskcluster/runlib/contester_proto"
+	"github.com/taskcluster/runlib/tools"
+)
+
+const (
+	// Largest chunk sent or accepted.
+	MAX_CHUNK_SIZE = 4 * 1024 * 1024
+	// Uploads without new chunks for this long are abandoned.
+	UPLOAD_TIMEOUT = 10 * time.Minute
+)
+
+// Chunked upload or download in progress.
+type upload struct {
+	next    uint64
+	hash    hash.Hash
+	sandbox *Sandbox
+	updated time.Time
+}
+
+type downloadKey struct {
+	name string
+	next uint64
+}
+
+// Forget the transfers in the sandbox, or all the abandoned ones if sandbox is nil. Must
+// be called with s.uploadMu held.
+func (s *Contester) dropUploads(sandbox *Sandbox) {
+	deadline := time.Now().Add(-UPLOAD_TIMEOUT)
+	drop := func(u *upload) bool {
+		return (sandbox == nil && u.updated.Before(deadline)) || (sandbox != nil && u.sandbox == sandbox)
+	}
+	for name, u := range s.uploads {
+		if drop(u) {
+			delete(s.uploads, name)
+		}
+	}
+	for key, u := range s.downloads {
+		if drop(u) {
+			delete(s.downloads, key)
+		}
+	}
+}
+
+// Same, taking the lock.
+func (s *Contester) dropSandboxUploads(sandbox *Sandbox) {
+	s.uploadMu.Lock()
+	defer s.uploadMu.Unlock()
+	s.dropUploads(sandbox)
+}
+
+// Uncompressed chunk data, checked against the blob SHA1 and digest.
+func readChunk(blob *contester_proto.Blob) ([]byte, error) {
+	if blob == nil {
+		return []byte{}, nil
+	}
+	r, err := blob.VerifiedReader()
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(r, MAX_CHUNK_SIZE+1))
+	if len(data) > MAX_CHUNK_SIZE {
+		return nil, errors.BadRequestf("Chunk is larger than %d bytes", MAX_CHUNK_SIZE)
+	}
+	if err != nil {
+		return nil, errors.Annotate(err, "Reading chunk")
+	}
+	return data, nil
+}
+
+func (s *Contester) PutChunk(request *contester_proto.PutChunkRequest, response *contester_proto.FileStat) error {
+	resolved, sandbox, err := resolvePath(s.Sandboxes, request.GetName(), true)
+	if err != nil {
+		return err
+	}
+	sandbox.Mutex.Lock()
+	defer sandbox.Mutex.Unlock()
+	if err = s.checkLease(sandbox, request.GetLease()); err != nil {
+		return err
+	}
+
+	data, err := readChunk(request.Data)
+	if err != nil {
+		return err
+	}
+	algo, expected := contester_proto.CHECKSUM_SHA1, request.Sha1
+	if request.Checksum != nil {
+		if algo, expected, err = contester_proto.ParseChecksum(request.GetChecksum()); err != nil {
+			return err
+		}
+	}
+
+	offset := request.GetOffset()
+	s.uploadMu.Lock()
+	s.dropUploads(nil)
+	current := s.uploads[resolved]
+	s.uploadMu.Unlock()
+
+	flags := os.O_WRONLY
+	if offset == 0 {
+		current = &upload{hash: sha1.New(), sandbox: sandbox}
+		flags |= os.O_CREATE | os.O_TRUNC
+	} else if current == nil || current.next != offset {
+		return errors.BadRequestf("Chunk of %s at offset %d is out of order", resolved, offset)
+	}
+
+	var destination *os.File
+	for {
+		destination, err = os.OpenFile(resolved, flags, DEFAULT_PUT_MODE)
+		loop, err := OnOsCreateError(err)
+
+		if err != nil {
+			return errors.Annotatef(err, "os.OpenFile(%q)", resolved)
+		}
+		if !loop {
+			break
+		}
+	}
+	_, err = destination.WriteAt(data, int64(offset))
+	destination.Close()
+	if err != nil {
+		return errors.Annotate(err, "destination.WriteAt")
+	}
+	if offset == 0 {
+		// Same as Put, regardless of umask and the mode of the file being replaced.
+		if err = os.Chmod(resolved, DEFAULT_PUT_MODE); err != nil {
+			return errors.Annotate(err, "os.Chmod")
+		}
+		if err = sandbox.Own(resolved); err != nil {
+			return err
+		}
+	}
+
+	current.hash.Write(data)
+	current.next += uint64(len(data))
+	current.updated = time.Now()
+
+	s.uploadMu.Lock()
+	if s.uploads == nil {
+		s.uploads = make(map[string]*upload)
+	}
+	if request.GetFinal() {
+		delete(s.uploads, resolved)
+	} else {
+		s.uploads[resolved] = current
+	}
+	s.uploadMu.Unlock()
+
+	if !request.GetFinal() {
+		return nil
+	}
+	if algo == contester_proto.CHECKSUM_SHA1 && expected != nil && !bytes.Equal(current.hash.Sum(nil), expected) {
+		os.Remove(resolved)
+		return errors.BadRequestf("SHA1 mismatch for %s", resolved)
+	}
+
+	// Other algorithms are only known with the last chunk, so the file is hashed again.
+	stat, err := tools.StatFile(resolved, algo)
+	if err != nil {
+		return err
+	}
+	if algo != contester_proto.CHECKSUM_SHA1 && stat.GetChecksum() != contester_proto.FormatChecksum(algo, expected) {
+		os.Remove(resolved)
+		return errors.BadRequestf("Checksum mismatch for %s, got %s", resolved, stat.GetChecksum())
+	}
+	*response = *stat
+	return nil
+}
+
+func (s *Contester) GetChunk(request *contester_proto.GetChunkRequest, response *contester_proto.FileChunk) error {
+	resolved, sandbox, err := resolvePath(s.Sandboxes, request.GetName(), false)
+	if err != nil {
+		return err
+	}
+	if sandbox != nil {
+		sandbox.Mutex.RLock()
+		defer sandbox.Mutex.RUnlock()
+	}
+	if err = s.checkPathLease(resolved, request.GetLease()); err != nil {
+		return err
+	}
+
+	source, err := os.Open(resolved)
+	if err != nil {
+		return errors.Annotatef(err, "os.Open(%q)", resolved)
+	}
+	defer source.Close()
+
+	st, err := source.Stat()
+	if err != nil {
+		return errors.Annotate(err, "source.Stat")
+	}
+	size := uint64(st.Size())
+	offset := request.GetOffset()
+	if offset > size {
+		return errors.BadRequestf("Offset %d is beyond the end of %s (%d)", offset, resolved, size)
+	}
+
+	length := request.GetLength()
+	if length == 0 || length > MAX_CHUNK_SIZE {
+		length = MAX_CHUNK_SIZE
+	}
+	if length > size-offset {
+		length = size - offset
+	}
+	data := make([]byte, length)
+	n, err := source.ReadAt(data, int64(offset))
+	if err != nil && err != io.EOF {
+		return errors.Annotate(err, "source.ReadAt")
+	}
+
+	response.Offset = proto.Uint64(offset)
+	response.FileSize = proto.Uint64(size)
+	if response.Data, err = contester_proto.NewBlob(data[:n]); err != nil {
+		return err
+	}
+
+	// Whole file SHA1 is computed as the file is read in order from the start.
+	key := downloadKey{resolved, offset}
+	s.uploadMu.Lock()
+	s.dropUploads(nil)
+	current := s.downloads[key]
+	delete(s.downloads, key)
+	s.uploadMu.Unlock()
+	if offset == 0 {
+		current = &upload{hash: sha1.New(), sandbox: sandbox}
+	}
+	if current != nil {
+		current.hash.Write(data[:n])
+		current.updated = time.Now()
+	}
+
+	if key.next += uint64(n); key.next < size {
+		if current != nil {
+			s.uploadMu.Lock()
+			if s.downloads == nil {
+				s.downloads = make(map[downloadKey]*upload)
+			}
+			s.downloads[key] = current
+			s.uploadMu.Unlock()
+		}
+		return nil
+	}
+	response.Eof = proto.Bool(true)
+	if current != nil {
+		response.Sha1 = current.hash.Sum(nil)
+	}
+	return nil
+}
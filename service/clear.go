@@ -39,10 +39,28 @@ func (s *Contester) Clear(request *contester_proto.ClearSandboxRequest, response
 	if err != nil {
 		return err
 	}
+	sandbox.Mutex.Lock()
+	defer sandbox.Mutex.Unlock()
+	if err = s.checkLease(sandbox, request.GetLease()); err != nil {
+		return err
+	}
+	s.dropSandboxUploads(sandbox)
+	return clearLocked(sandbox)
+}
 
+func clearSandbox(sandbox *Sandbox) error {
 	sandbox.Mutex.Lock()
 	defer sandbox.Mutex.Unlock()
+	return clearLocked(sandbox)
+}
+
+// Same as clearSandbox, with sandbox.Mutex held.
+func clearLocked(sandbox *Sandbox) error {
+	if sandbox.Overlay != nil {
+		return sandbox.resetOverlay()
+	}
 
+	var err error
 	repeat := true
 
 	for retries := 10; retries > 0 && repeat; retries-- {
@@ -1,7 +1,13 @@
 package service
 
 import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"strconv"
+
 	"github.com/golang/protobuf/proto"
+	"github.com/juju/errors"
 	"github.com/taskcluster/runlib/contester_proto"
 	"github.com/taskcluster/runlib/subprocess"
 )
@@ -76,7 +82,20 @@ func fillRedirect(r *contester_proto.RedirectParameters) *subprocess.Redirect {
 	}
 
 	result := &subprocess.Redirect{}
-	if r.Filename != nil {
+	if r.GetPty() {
+		result.Mode = subprocess.REDIRECT_PTY
+		result.Filename = r.Filename
+		if r.Buffer != nil {
+			result.Data, _ = r.Buffer.Bytes()
+		}
+	} else if r.Filename != nil && r.GetTee() {
+		result.Filename = r.Filename
+		result.Mode = subprocess.REDIRECT_TEE
+		result.Head, result.Tail = int(r.GetHead()), int(r.GetTail())
+		if result.Head == 0 && result.Tail == 0 {
+			result.Head = subprocess.MAX_MEM_OUTPUT
+		}
+	} else if r.Filename != nil {
 		result.Filename = r.Filename
 		result.Mode = subprocess.REDIRECT_FILE
 	} else if r.Memory != nil && *r.Memory {
@@ -88,6 +107,35 @@ func fillRedirect(r *contester_proto.RedirectParameters) *subprocess.Redirect {
 	return result
 }
 
+// Same as fillRedirect, but the blob is decompressed on the fly while the process reads it,
+// instead of holding the whole input in memory.
+func fillInputRedirect(r *contester_proto.RedirectParameters) *subprocess.Redirect {
+	if r != nil && r.Filename != nil && !r.GetPty() {
+		return &subprocess.Redirect{
+			Mode:     subprocess.REDIRECT_FILE,
+			Filename: r.Filename,
+		}
+	}
+	if r == nil || !(r.GetMemory() || r.GetPty()) || r.Buffer == nil {
+		return fillRedirect(r)
+	}
+
+	reader, err := r.Buffer.Reader()
+	if err != nil {
+		return fillRedirect(r)
+	}
+	if r.GetPty() {
+		return &subprocess.Redirect{
+			Mode:   subprocess.REDIRECT_PTY,
+			Reader: reader,
+		}
+	}
+	return &subprocess.Redirect{
+		Mode:   subprocess.REDIRECT_STREAM,
+		Reader: reader,
+	}
+}
+
 func findSandbox(s []SandboxPair, request *contester_proto.LocalExecutionParameters) (*Sandbox, error) {
 	if request.SandboxId != nil {
 		return getSandboxById(s, request.GetSandboxId())
@@ -95,6 +143,25 @@ func findSandbox(s []SandboxPair, request *contester_proto.LocalExecutionParamet
 	return getSandboxByPath(s, request.GetCurrentDirectory())
 }
 
+// Failures to start the process are reported in the result, user_error tells the ones
+// caused by the request from the invoker-side ones. Other errors are returned as is.
+func fillSetupFailure(err error, response *contester_proto.LocalExecutionResult) error {
+	se, ok := errors.Cause(err).(*subprocess.SetupError)
+	if !ok {
+		return err
+	}
+	response.SetupFailure = &contester_proto.SetupFailure{
+		Stage:     proto.String(se.Stage),
+		Errno:     proto.Uint32(uint32(se.Errno)),
+		UserError: proto.Bool(se.UserError()),
+		Message:   proto.String(se.Error()),
+	}
+	if se.Path != "" {
+		response.SetupFailure.Path = proto.String(se.Path)
+	}
+	return nil
+}
+
 func fillResult(result *subprocess.SubprocessResult, response *contester_proto.LocalExecutionResult) {
 	if result.TotalProcesses > 0 {
 		response.TotalProcesses = proto.Uint64(result.TotalProcesses)
@@ -105,21 +172,43 @@ func fillResult(result *subprocess.SubprocessResult, response *contester_proto.L
 	response.Memory = proto.Uint64(result.PeakMemory)
 	response.StdOut, _ = contester_proto.NewBlob(result.Output)
 	response.StdErr, _ = contester_proto.NewBlob(result.Error)
+	if result.OutputTruncated {
+		response.StdOutTruncated = proto.Bool(true)
+	}
+	if result.ErrorTruncated {
+		response.StdErrTruncated = proto.Bool(true)
+	}
 }
 
 func (s *Contester) setupSubprocess(request *contester_proto.LocalExecutionParameters, sandbox *Sandbox, doRedirects bool) (sub *subprocess.Subprocess, err error) {
 	sub = subprocess.SubprocessCreate()
+	defer func() {
+		if err != nil {
+			sub.ReleaseRedirects()
+		}
+	}()
 
 	sub.Cmd = &subprocess.CommandLine{
 		ApplicationName: request.ApplicationName,
 		CommandLine:     request.CommandLine,
 		Parameters:      request.CommandLineParameters,
+		SearchPath:      request.GetSearchPath(),
 	}
 
-	sub.CurrentDirectory = request.CurrentDirectory
+	if request.CurrentDirectory != nil {
+		var dir string
+		if dir, _, err = resolvePath(s.Sandboxes, request.GetCurrentDirectory(), true); err != nil {
+			return
+		}
+		sub.CurrentDirectory = &dir
+	}
 
 	sub.TimeLimit = subprocess.DuFromMicros(request.GetTimeLimitMicros())
 	sub.HardTimeLimit = subprocess.DuFromMicros(request.GetTimeLimitHardMicros())
+	if request.GetNormalizeTime() {
+		factor := s.getSpeedFactor()
+		sub.TimeLimit, sub.HardTimeLimit = scaleLimit(sub.TimeLimit, factor), scaleLimit(sub.HardTimeLimit, factor)
+	}
 	sub.MemoryLimit = request.GetMemoryLimit()
 	sub.CheckIdleness = request.GetCheckIdleness()
 	sub.RestrictUi = request.GetRestrictUi()
@@ -128,7 +217,7 @@ func (s *Contester) setupSubprocess(request *contester_proto.LocalExecutionParam
 	sub.Environment = fillEnv(request.Environment)
 
 	if doRedirects {
-		sub.StdIn = fillRedirect(request.StdIn)
+		sub.StdIn = fillInputRedirect(request.StdIn)
 		sub.StdOut = fillRedirect(request.StdOut)
 	}
 
@@ -137,6 +226,11 @@ func (s *Contester) setupSubprocess(request *contester_proto.LocalExecutionParam
 	} else {
 		sub.StdErr = fillRedirect(request.StdErr)
 	}
+	for _, r := range []*subprocess.Redirect{sub.StdIn, sub.StdOut, sub.StdErr} {
+		if err = s.resolveRedirect(r, sub.CurrentDirectory); err != nil {
+			return
+		}
+	}
 
 	sub.Options = &subprocess.PlatformOptions{}
 
@@ -155,6 +249,24 @@ func (s *Contester) setupSubprocess(request *contester_proto.LocalExecutionParam
 	return
 }
 
+// Redirect files are opened by the service, so they go through the same resolution as
+// other paths in sandboxes. Relative names are relative to the current directory.
+func (s *Contester) resolveRedirect(r *subprocess.Redirect, cwd *string) error {
+	if r == nil || r.Filename == nil {
+		return nil
+	}
+	name := *r.Filename
+	if cwd != nil && name != "" && name[0] != '%' && !filepath.IsAbs(name) {
+		name = filepath.Join(*cwd, name)
+	}
+	resolved, _, err := resolvePath(s.Sandboxes, name, true)
+	if err != nil {
+		return err
+	}
+	r.Filename = &resolved
+	return nil
+}
+
 func chmodRequestIfNeeded(sandbox *Sandbox, request *contester_proto.LocalExecutionParameters) error {
 	if request.ApplicationName != nil {
 		return chmodIfNeeded(*request.ApplicationName, sandbox)
@@ -167,9 +279,11 @@ func (s *Contester) LocalExecute(request *contester_proto.LocalExecutionParamete
 	if err != nil {
 		return err
 	}
-
 	sandbox.Mutex.Lock()
 	defer sandbox.Mutex.Unlock()
+	if err = s.checkLease(sandbox, request.GetLease()); err != nil {
+		return err
+	}
 
 	err = chmodRequestIfNeeded(sandbox, request)
 	if err != nil {
@@ -182,29 +296,63 @@ func (s *Contester) LocalExecute(request *contester_proto.LocalExecutionParamete
 		return err
 	}
 
-	result, err := sub.Execute()
+	if request.GetRepeat() > 1 {
+		// Streamed input can't be replayed.
+		sub.ReleaseRedirects()
+		sub.StdIn = fillRedirect(request.StdIn)
+		if err = s.resolveRedirect(sub.StdIn, sub.CurrentDirectory); err != nil {
+			return err
+		}
+	}
 
+	attached, err := s.attachRedirects(request, sandbox, sub)
 	if err != nil {
+		sub.ReleaseRedirects()
 		return err
 	}
+	defer attached.Close()
+
+	usage := sandbox.diskUsageBefore()
+	if request.GetRepeat() > 1 {
+		result, err := subprocess.ExecuteRepeated(s.Executor, sub, int(request.GetRepeat()), int(request.GetRepeatPolicy()))
+		if err != nil {
+			return fillSetupFailure(err, response)
+		}
+		fillResult(result.SubprocessResult, response)
+		s.fillNormalizedTime(request, result.SubprocessResult, response)
+		sandbox.fillQuotaExceeded(usage, response)
+		response.RepeatStatistics = &contester_proto.RepeatStatistics{
+			Runs:           proto.Uint32(uint32(len(result.Runs))),
+			UserTimeMicros: fillRange(result.UserTime),
+			WallTimeMicros: fillRange(result.WallTime),
+			Memory:         fillRange(result.PeakMemory),
+		}
+		return nil
+	}
+
+	result, err := s.Executor.Execute(sub)
+
+	if err != nil {
+		return fillSetupFailure(err, response)
+	}
 
 	fillResult(result, response)
+	s.fillNormalizedTime(request, result, response)
+	sandbox.fillQuotaExceeded(usage, response)
 
 	return nil
 }
 
-type runResult struct {
-	second bool
-	r      *subprocess.SubprocessResult
-	e      error
+func fillRange(r subprocess.Range) *contester_proto.RepeatStatistics_Range {
+	return &contester_proto.RepeatStatistics_Range{
+		Min:    proto.Uint64(r.Min),
+		Median: proto.Uint64(r.Median),
+		Max:    proto.Uint64(r.Max),
+	}
 }
 
-func execAndSend(sub *subprocess.Subprocess, c chan runResult, second bool) {
-	var r runResult
-	r.second = second
-	r.r, r.e = sub.Execute()
-	c <- r
-}
+// Transcripts larger than this are truncated.
+const MAX_TRANSCRIPT_SIZE = 16 * 1024 * 1024
 
 func (s *Contester) LocalExecuteConnected(request *contester_proto.LocalExecuteConnected, response *contester_proto.LocalExecuteConnectedResult) error {
 	firstSandbox, err := findSandbox(s.Sandboxes, request.First)
@@ -217,11 +365,13 @@ func (s *Contester) LocalExecuteConnected(request *contester_proto.LocalExecuteC
 		return err
 	}
 
-	firstSandbox.Mutex.Lock()
-	defer firstSandbox.Mutex.Unlock()
-
-	secondSandbox.Mutex.Lock()
-	defer secondSandbox.Mutex.Unlock()
+	defer lockSandboxes([]*Sandbox{firstSandbox, secondSandbox})()
+	if err = s.checkLease(firstSandbox, request.First.GetLease()); err != nil {
+		return err
+	}
+	if err = s.checkLease(secondSandbox, request.Second.GetLease()); err != nil {
+		return err
+	}
 
 	err = chmodRequestIfNeeded(firstSandbox, request.First)
 	if err != nil {
@@ -240,40 +390,148 @@ func (s *Contester) LocalExecuteConnected(request *contester_proto.LocalExecuteC
 
 	second, err := s.setupSubprocess(request.Second, secondSandbox, false)
 	if err != nil {
+		first.ReleaseRedirects()
 		return err
 	}
 
-	err = subprocess.Interconnect(first, second, nil, nil)
-	if err != nil {
+	var transcript *subprocess.Transcript
+	var transcriptData bytes.Buffer
+	var input, output io.Writer
+	if request.GetRecordTranscript() {
+		transcript = subprocess.NewTranscript(&transcriptData)
+		transcript.MaxSize = MAX_TRANSCRIPT_SIZE
+		input, output = transcript.Writer(subprocess.TRANSCRIPT_INPUT), transcript.Writer(subprocess.TRANSCRIPT_OUTPUT)
+	}
+
+	topology := subprocess.Interconnected(first, second, input, output)
+	topology.Executor = s.Executor
+	if err = topology.Connect(); err != nil {
+		topology.Release()
 		return err
 	}
 
-	cs := make(chan runResult, 1)
-	outstanding := 2
+	firstUsage, secondUsage := firstSandbox.diskUsageBefore(), secondSandbox.diskUsageBefore()
+	results, errs := topology.Execute()
+
+	response.First = &contester_proto.LocalExecutionResult{}
+	if errs[0] != nil {
+		if e := fillSetupFailure(errs[0], response.First); e != nil {
+			response.First, err = nil, e
+		}
+	} else {
+		fillResult(results[0], response.First)
+		s.fillNormalizedTime(request.First, results[0], response.First)
+		firstSandbox.fillQuotaExceeded(firstUsage, response.First)
+	}
+	response.Second = &contester_proto.LocalExecutionResult{}
+	if errs[1] != nil {
+		if e := fillSetupFailure(errs[1], response.Second); e != nil {
+			response.Second, err = nil, e
+		}
+	} else {
+		fillResult(results[1], response.Second)
+		s.fillNormalizedTime(request.Second, results[1], response.Second)
+		secondSandbox.fillQuotaExceeded(secondUsage, response.Second)
+	}
+
+	if transcript != nil {
+		var blobErr error
+		if response.Transcript, blobErr = contester_proto.NewBlob(transcriptData.Bytes()); blobErr != nil {
+			return blobErr
+		}
+		if transcript.Truncated() {
+			response.TranscriptTruncated = proto.Bool(true)
+		}
+	}
+
+	return err
+}
 
-	go execAndSend(first, cs, false)
-	go execAndSend(second, cs, true)
+func (s *Contester) LocalExecuteGraph(request *contester_proto.LocalExecuteGraph, response *contester_proto.LocalExecuteGraphResult) error {
+	sandboxes := make([]*Sandbox, len(request.Processes))
+	for i, params := range request.Processes {
+		var err error
+		if sandboxes[i], err = findSandbox(s.Sandboxes, params); err != nil {
+			return err
+		}
+	}
 
-	for outstanding > 0 {
-		r := <-cs
-		outstanding--
+	defer lockSandboxes(sandboxes)()
+	for i, params := range request.Processes {
+		if err := s.checkLease(sandboxes[i], params.GetLease()); err != nil {
+			return err
+		}
+	}
+
+	var topology subprocess.Topology
+	topology.Executor = s.Executor
+	topology.Processes = make([]*subprocess.Subprocess, len(request.Processes))
+	for i, params := range request.Processes {
+		err := chmodRequestIfNeeded(sandboxes[i], params)
+		if err == nil {
+			// Redirects are set up for all descriptors, edges will override them.
+			topology.Processes[i], err = s.setupSubprocess(params, sandboxes[i], true)
+		}
+		if err != nil {
+			topology.Processes[i] = nil
+			topology.Release()
+			return err
+		}
+	}
 
-		if r.second {
-			if r.e != nil {
-				err = r.e
-			} else {
-				response.Second = &contester_proto.LocalExecutionResult{}
-				fillResult(r.r, response.Second)
+	var transcript *subprocess.Transcript
+	var transcriptData bytes.Buffer
+	topology.Edges = make([]subprocess.Edge, len(request.Edges))
+	for i, e := range request.Edges {
+		topology.Edges[i] = subprocess.Edge{
+			From:   int(e.GetSource()),
+			FromFd: int(e.GetSourceFd()),
+			To:     int(e.GetDestination()),
+			ToFd:   int(e.GetDestinationFd()),
+		}
+		if e.GetRecord() {
+			if transcript == nil {
+				transcript = subprocess.NewTranscript(&transcriptData)
+				transcript.MaxSize = MAX_TRANSCRIPT_SIZE
 			}
-		} else {
-			if r.e != nil {
-				err = r.e
-			} else {
-				response.First = &contester_proto.LocalExecutionResult{}
-				fillResult(r.r, response.First)
+			topology.Edges[i].Record = transcript.Writer(strconv.Itoa(i))
+		}
+	}
+
+	if err := topology.Connect(); err != nil {
+		topology.Release()
+		return err
+	}
+
+	usage := make([]*diskUsage, len(sandboxes))
+	for i, sandbox := range sandboxes {
+		usage[i] = sandbox.diskUsageBefore()
+	}
+	results, errs := topology.Execute()
+
+	var err error
+	response.Results = make([]*contester_proto.LocalExecutionResult, len(results))
+	for i, r := range results {
+		response.Results[i] = &contester_proto.LocalExecutionResult{}
+		if errs[i] != nil {
+			if e := fillSetupFailure(errs[i], response.Results[i]); e != nil {
+				err = e
 			}
+			continue
 		}
+		fillResult(r, response.Results[i])
+		s.fillNormalizedTime(request.Processes[i], r, response.Results[i])
+		sandboxes[i].fillQuotaExceeded(usage[i], response.Results[i])
 	}
 
+	if transcript != nil {
+		var blobErr error
+		if response.Transcript, blobErr = contester_proto.NewBlob(transcriptData.Bytes()); blobErr != nil {
+			return blobErr
+		}
+		if transcript.Truncated() {
+			response.TranscriptTruncated = proto.Bool(true)
+		}
+	}
 	return err
 }
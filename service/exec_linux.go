@@ -2,8 +2,9 @@ package service
 
 import (
 	"os"
-	"strings"
+	"path/filepath"
 
+	"github.com/juju/errors"
 	"github.com/taskcluster/runlib/contester_proto"
 	"github.com/taskcluster/runlib/subprocess"
 )
@@ -12,13 +13,32 @@ func (s *Contester) localPlatformSetup(sub *subprocess.Subprocess, request *cont
 	return nil
 }
 
+// Make the program in the sandbox executable. The name is resolved inside the sandbox,
+// and only regular files are changed, never the targets of symlinks.
 func chmodIfNeeded(filename string, sandbox *Sandbox) error {
-	if !strings.HasPrefix(filename, sandbox.Path) {
+	filename = filepath.Clean(filename)
+	if !isBeneath(sandbox.Path, filename) {
 		return nil
 	}
-	s, err := os.Stat(filename)
+	rel, err := filepath.Rel(sandbox.Path, filename)
+	if err != nil {
+		return errors.Annotate(err, "filepath.Rel")
+	}
+	if filename, err = secureJoin(sandbox.Path, rel); err != nil {
+		return err
+	}
+	s, err := os.Lstat(filename)
 	if err != nil {
 		return err
 	}
+	if !s.Mode().IsRegular() {
+		return errors.BadRequestf("%s is not a regular file", filename)
+	}
+	// Shared with the download cache, so the sandbox gets its own copy to change.
+	if isHardlinked(s) {
+		if _, err = copyFile(filename, filename, sandbox); err != nil {
+			return err
+		}
+	}
 	return os.Chmod(filename, s.Mode()|0100)
 }
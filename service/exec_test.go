@@ -0,0 +1,384 @@
+// +build linux
+
+package service
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/juju/errors"
+	"github.com/taskcluster/runlib/contester_proto"
+	"github.com/taskcluster/runlib/subprocess"
+)
+
+func testParams(sandboxId string) *contester_proto.LocalExecutionParameters {
+	return &contester_proto.LocalExecutionParameters{
+		ApplicationName:  proto.String("/bin/true"),
+		SandboxId:        proto.String(sandboxId),
+		TimeLimitMicros:  proto.Uint64(1000000),
+		CurrentDirectory: proto.String(sandboxId),
+	}
+}
+
+func TestLocalExecute(t *testing.T) {
+	c, executor, cleanup := newTestContester(t, func(sub *subprocess.Subprocess) (*subprocess.SubprocessResult, error) {
+		r := &subprocess.SubprocessResult{
+			ExitCode:    3,
+			SuccessCode: subprocess.EF_TIME_LIMIT_HIT,
+			Output:      []byte("output"),
+		}
+		r.UserTime = 1500 * time.Millisecond
+		return r, nil
+	})
+	defer cleanup()
+
+	request := testParams("%0.R")
+	request.StdOut = &contester_proto.RedirectParameters{Memory: proto.Bool(true)}
+	var response contester_proto.LocalExecutionResult
+	if err := c.LocalExecute(request, &response); err != nil {
+		t.Fatal(err)
+	}
+
+	if response.GetReturnCode() != 3 || !response.GetFlags().GetTimeLimitHit() {
+		t.Errorf("Unexpected result %v", &response)
+	}
+	if response.GetTime().GetUserTimeMicros() != 1500000 {
+		t.Errorf("Expected user time 1500000, got %d", response.GetTime().GetUserTimeMicros())
+	}
+	if out, _ := response.StdOut.Bytes(); string(out) != "output" {
+		t.Errorf("Expected output %q, got %q", "output", out)
+	}
+
+	executed := executor.Executed()
+	if len(executed) != 1 {
+		t.Fatalf("Expected 1 execution, got %d", len(executed))
+	}
+	if executed[0].StdOut.Mode != subprocess.REDIRECT_MEMORY || executed[0].TimeLimit != time.Second {
+		t.Errorf("Subprocess is set up incorrectly: %+v", executed[0])
+	}
+}
+
+func TestLocalExecuteRedirectPaths(t *testing.T) {
+	c, executor, cleanup := newTestContester(t, func(sub *subprocess.Subprocess) (*subprocess.SubprocessResult, error) {
+		return &subprocess.SubprocessResult{}, nil
+	})
+	defer cleanup()
+
+	run := c.Sandboxes[0].Run.Path
+	if err := os.Symlink("/etc", filepath.Join(run, "escape")); err != nil {
+		t.Fatal(err)
+	}
+
+	request := testParams("%0.R")
+	request.StdOut = &contester_proto.RedirectParameters{Filename: proto.String("output.txt")}
+	request.StdErr = &contester_proto.RedirectParameters{Filename: proto.String("%0.R/error.txt")}
+	if err := c.LocalExecute(request, &contester_proto.LocalExecutionResult{}); err != nil {
+		t.Fatal(err)
+	}
+	executed := executor.Executed()
+	if len(executed) != 1 {
+		t.Fatalf("Expected 1 execution, got %d", len(executed))
+	}
+	sub := executed[0]
+	if *sub.CurrentDirectory != run || *sub.StdOut.Filename != filepath.Join(run, "output.txt") || *sub.StdErr.Filename != filepath.Join(run, "error.txt") {
+		t.Errorf("Paths are resolved incorrectly: %s, %s, %s", *sub.CurrentDirectory, *sub.StdOut.Filename, *sub.StdErr.Filename)
+	}
+
+	for _, name := range []string{"escape/passwd", "../outside.txt", "/etc/passwd"} {
+		request.StdOut = &contester_proto.RedirectParameters{Filename: proto.String(name)}
+		if err := c.LocalExecute(request, &contester_proto.LocalExecutionResult{}); !errors.IsBadRequest(err) {
+			t.Errorf("Expected bad request error for redirect to %s, got %v", name, err)
+		}
+	}
+	request = testParams("%0.R")
+	request.CurrentDirectory = proto.String("%0.R/escape")
+	if err := c.LocalExecute(request, &contester_proto.LocalExecutionResult{}); !errors.IsBadRequest(err) {
+		t.Errorf("Expected bad request error for current directory outside of the sandbox, got %v", err)
+	}
+}
+
+func TestChmodIfNeeded(t *testing.T) {
+	c, _, cleanup := newTestContester(t, nil)
+	defer cleanup()
+
+	sandbox := &c.Sandboxes[0].Run
+	outside := filepath.Join(filepath.Dir(sandbox.Path), "outside")
+	for _, name := range []string{outside, filepath.Join(sandbox.Path, "sol"), sandbox.Path + "2"} {
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte("#!/bin/true"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	links := map[string]string{"absolute": outside, "relative": "../outside"}
+	for name, target := range links {
+		if err := os.Symlink(target, filepath.Join(sandbox.Path, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := chmodIfNeeded(filepath.Join(sandbox.Path, "sol"), sandbox); err != nil {
+		t.Fatal(err)
+	}
+	if info, err := os.Stat(filepath.Join(sandbox.Path, "sol")); err != nil || info.Mode().Perm() != 0744 {
+		t.Errorf("Program is not made executable: %v (%v)", info, err)
+	}
+	for name := range links {
+		if err := chmodIfNeeded(filepath.Join(sandbox.Path, name), sandbox); !errors.IsBadRequest(err) {
+			t.Errorf("Expected bad request for %s, got %v", name, err)
+		}
+	}
+	if err := chmodIfNeeded(sandbox.Path, sandbox); !errors.IsBadRequest(err) {
+		t.Errorf("Expected bad request for the sandbox directory, got %v", err)
+	}
+	if err := chmodIfNeeded(sandbox.Path+"2", sandbox); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{outside, sandbox.Path + "2"} {
+		if info, err := os.Stat(name); err != nil || info.Mode().Perm() != 0644 {
+			t.Errorf("File outside of the sandbox is changed: %v (%v)", info, err)
+		}
+	}
+}
+
+func TestLocalExecuteAttachConflicts(t *testing.T) {
+	c, executor, cleanup := newTestContester(t, nil)
+	defer cleanup()
+
+	blob, err := contester_proto.NewBlob([]byte("input"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, params := range []*contester_proto.RedirectParameters{
+		{Fifo: proto.String("%0.R/in"), Memory: proto.Bool(true), Buffer: blob},
+		{Fifo: proto.String("%0.R/in"), Filename: proto.String("input.txt")},
+		{Fifo: proto.String("%0.R/in"), Socket: proto.String("%0.R/socket")},
+	} {
+		request := testParams("%0.R")
+		request.StdIn = params
+		if err := c.LocalExecute(request, &contester_proto.LocalExecutionResult{}); !errors.IsBadRequest(err) {
+			t.Errorf("Expected bad request for %v, got %v", params, err)
+		}
+	}
+	if len(executor.Executed()) != 0 {
+		t.Errorf("Process with conflicting redirects was started")
+	}
+	if files, _ := ioutil.ReadDir(c.Sandboxes[0].Run.Path); len(files) != 0 {
+		t.Errorf("Attach points are left in the sandbox: %d files", len(files))
+	}
+}
+
+func TestLocalExecuteError(t *testing.T) {
+	c, _, cleanup := newTestContester(t, func(sub *subprocess.Subprocess) (*subprocess.SubprocessResult, error) {
+		return nil, errors.BadRequestf("No such file")
+	})
+	defer cleanup()
+
+	var response contester_proto.LocalExecutionResult
+	if err := c.LocalExecute(testParams("%0.R"), &response); !errors.IsBadRequest(err) {
+		t.Errorf("Expected bad request error, got %v", err)
+	}
+	if err := c.LocalExecute(testParams("%5.R"), &response); !errors.IsBadRequest(err) {
+		t.Errorf("Expected bad request error for unknown sandbox, got %v", err)
+	}
+}
+
+func TestLocalExecuteSetupFailure(t *testing.T) {
+	c, _, cleanup := newTestContester(t, func(sub *subprocess.Subprocess) (*subprocess.SubprocessResult, error) {
+		return nil, errors.Annotate(&subprocess.SetupError{Stage: "exec", Errno: syscall.ENOENT, Path: "/bin/nothing"}, "CreateFrozen")
+	})
+	defer cleanup()
+
+	var response contester_proto.LocalExecutionResult
+	if err := c.LocalExecute(testParams("%0.R"), &response); err != nil {
+		t.Fatal(err)
+	}
+	f := response.GetSetupFailure()
+	if f.GetStage() != "exec" || f.GetErrno() != uint32(syscall.ENOENT) || f.GetPath() != "/bin/nothing" || !f.GetUserError() {
+		t.Errorf("Unexpected setup failure %v", f)
+	}
+}
+
+func TestLocalExecuteInvokerSetupFailure(t *testing.T) {
+	c, _, cleanup := newTestContester(t, func(sub *subprocess.Subprocess) (*subprocess.SubprocessResult, error) {
+		return nil, errors.Annotate(&subprocess.SetupError{Stage: "exec", Errno: syscall.ENOMEM}, "CreateFrozen")
+	})
+	defer cleanup()
+
+	var response contester_proto.LocalExecutionResult
+	if err := c.LocalExecute(testParams("%0.R"), &response); err != nil {
+		t.Fatal(err)
+	}
+	failure := response.SetupFailure
+	if failure == nil || failure.GetUserError() || failure.GetStage() != "exec" || failure.GetErrno() != uint32(syscall.ENOMEM) {
+		t.Errorf("Unexpected setup failure %v", failure)
+	}
+}
+
+func TestLocalExecuteRepeat(t *testing.T) {
+	times := []time.Duration{300, 100, 200}
+	var runs int
+	c, _, cleanup := newTestContester(t, func(sub *subprocess.Subprocess) (*subprocess.SubprocessResult, error) {
+		r := &subprocess.SubprocessResult{}
+		r.UserTime = times[runs] * time.Millisecond
+		runs++
+		return r, nil
+	})
+	defer cleanup()
+
+	request := testParams("%0.R")
+	request.Repeat = proto.Uint32(3)
+	request.RepeatPolicy = contester_proto.LocalExecutionParameters_BEST.Enum()
+	var response contester_proto.LocalExecutionResult
+	if err := c.LocalExecute(request, &response); err != nil {
+		t.Fatal(err)
+	}
+
+	stats := response.GetRepeatStatistics()
+	if stats.GetRuns() != 3 || stats.GetUserTimeMicros().GetMin() != 100000 ||
+		stats.GetUserTimeMicros().GetMedian() != 200000 || stats.GetUserTimeMicros().GetMax() != 300000 {
+		t.Errorf("Unexpected statistics %v", stats)
+	}
+	if response.GetTime().GetUserTimeMicros() != 100000 {
+		t.Errorf("Expected the best run to be reported, got %v", response.GetTime())
+	}
+}
+
+func TestLocalExecuteConnected(t *testing.T) {
+	c, executor, cleanup := newTestContester(t, func(sub *subprocess.Subprocess) (*subprocess.SubprocessResult, error) {
+		return &subprocess.SubprocessResult{ExitCode: uint32(len(*sub.Cmd.ApplicationName))}, nil
+	})
+	defer cleanup()
+
+	// Both processes in the same sandbox must not deadlock.
+	request := &contester_proto.LocalExecuteConnected{
+		First:            testParams("%0.R"),
+		Second:           testParams("%0.R"),
+		RecordTranscript: proto.Bool(true),
+	}
+	request.Second.ApplicationName = proto.String("/bin/false")
+
+	done := make(chan error, 1)
+	var response contester_proto.LocalExecuteConnectedResult
+	go func() {
+		done <- c.LocalExecuteConnected(request, &response)
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("LocalExecuteConnected is stuck")
+	}
+
+	if response.GetFirst().GetReturnCode() != 9 || response.GetSecond().GetReturnCode() != 10 {
+		t.Errorf("Results are mixed up: %v", &response)
+	}
+	for _, sub := range executor.Executed() {
+		if sub.StdIn.Mode != subprocess.REDIRECT_PIPE || sub.StdOut.Mode != subprocess.REDIRECT_PIPE {
+			t.Errorf("Processes are not connected: %+v", sub)
+		}
+	}
+}
+
+// Drains stdin and writes the program name to stdout, if they are pipes.
+type echoExecutor struct{}
+
+func (echoExecutor) Execute(sub *subprocess.Subprocess) (*subprocess.SubprocessResult, error) {
+	if sub.StdIn != nil && sub.StdIn.Mode == subprocess.REDIRECT_PIPE {
+		ioutil.ReadAll(sub.StdIn.Pipe)
+		sub.StdIn.Pipe.Close()
+	}
+	if sub.StdOut != nil && sub.StdOut.Mode == subprocess.REDIRECT_PIPE {
+		sub.StdOut.Pipe.Write([]byte(*sub.Cmd.ApplicationName))
+		sub.StdOut.Pipe.Close()
+	}
+	return &subprocess.SubprocessResult{}, nil
+}
+
+func TestLocalExecuteGraphRecord(t *testing.T) {
+	c, _, cleanup := newTestContester(t, nil)
+	defer cleanup()
+	c.Executor = echoExecutor{}
+
+	request := &contester_proto.LocalExecuteGraph{
+		Processes: []*contester_proto.LocalExecutionParameters{testParams("%0.R"), testParams("%0.R"), testParams("%0.R")},
+		Edges: []*contester_proto.LocalExecuteGraph_Edge{
+			{Source: proto.Uint32(0), SourceFd: proto.Uint32(1), Destination: proto.Uint32(1), DestinationFd: proto.Uint32(0)},
+			{Source: proto.Uint32(1), SourceFd: proto.Uint32(1), Destination: proto.Uint32(2), DestinationFd: proto.Uint32(0), Record: proto.Bool(true)},
+		},
+	}
+	request.Processes[1].ApplicationName = proto.String("/bin/false")
+	var response contester_proto.LocalExecuteGraphResult
+	if err := c.LocalExecuteGraph(request, &response); err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Results) != 3 || response.Transcript == nil || response.GetTranscriptTruncated() {
+		t.Fatalf("Unexpected result %v", &response)
+	}
+
+	data, err := response.Transcript.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorded := make(map[string]string)
+	err = subprocess.ReadTranscript(bytes.NewReader(data), func(e *subprocess.TranscriptEntry) error {
+		recorded[e.Direction] += string(e.Data)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recorded) != 1 || recorded["1"] != "/bin/false" {
+		t.Errorf("Expected only the second edge to be recorded, got %v", recorded)
+	}
+}
+
+func TestSandboxLockedDuringExecution(t *testing.T) {
+	started, release := make(chan bool), make(chan bool)
+	c, _, cleanup := newTestContester(t, func(sub *subprocess.Subprocess) (*subprocess.SubprocessResult, error) {
+		started <- true
+		<-release
+		return &subprocess.SubprocessResult{}, nil
+	})
+	defer cleanup()
+
+	executed := make(chan error, 1)
+	go func() {
+		executed <- c.LocalExecute(testParams("%0.R"), &contester_proto.LocalExecutionResult{})
+	}()
+	<-started
+
+	blob, _ := contester_proto.NewBlob([]byte("data"))
+	put := make(chan error, 1)
+	go func() {
+		put <- c.Put(&contester_proto.FileBlob{Name: proto.String("%0.R/file"), Data: blob}, &contester_proto.FileStat{})
+	}()
+
+	select {
+	case <-put:
+		t.Fatal("Put to the sandbox succeeded while the process was running")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Other sandboxes are not affected.
+	if err := c.Put(&contester_proto.FileBlob{Name: proto.String("%1.R/file"), Data: blob}, &contester_proto.FileStat{}); err != nil {
+		t.Fatal(err)
+	}
+
+	close(release)
+	if err := <-executed; err != nil {
+		t.Fatal(err)
+	}
+	if err := <-put; err != nil {
+		t.Fatal(err)
+	}
+}
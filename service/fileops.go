@@ -1,6 +1,7 @@
 package service
 
 import (
+	"os"
 	"path/filepath"
 
 	"github.com/juju/errors"
@@ -8,42 +9,235 @@ import (
 	"github.com/taskcluster/runlib/tools"
 )
 
-func (s *Contester) Stat(request *contester_proto.StatRequest, response *contester_proto.FileStats) error {
+// Same as resolvePath, but the last component is not followed if it's a symlink, so
+// operations apply to the link itself.
+func resolvePathNoFollow(s []SandboxPair, source string, restricted bool) (string, *Sandbox, error) {
+	dir, base := filepath.Split(source)
+	if dir == "" || base == "" || base == "." || base == ".." {
+		return resolvePath(s, source, restricted)
+	}
+	resolved, sandbox, err := resolvePath(s, dir, restricted)
+	if err != nil {
+		return source, nil, err
+	}
+	return filepath.Join(resolved, base), sandbox, nil
+}
+
+type resolvedPair struct {
+	source, destination string
+	sandbox             *Sandbox // of the destination
+}
+
+// Resolve the pairs, check leases and lock all the sandboxes involved. Call the returned
+// function to unlock them.
+func (s *Contester) resolvePairs(request *contester_proto.RepeatedNamePairEntries, move bool) ([]resolvedPair, func(), error) {
+	var sandboxes []*Sandbox
 	if request.SandboxId != nil {
-		sandbox, err := getSandboxById(s.Sandboxes, *request.SandboxId)
+		sandbox, err := getSandboxById(s.Sandboxes, request.GetSandboxId())
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
-		sandbox.Mutex.RLock()
-		defer sandbox.Mutex.RUnlock()
+		sandboxes = append(sandboxes, sandbox)
 	}
 
-	response.Entries = make([]*contester_proto.FileStat, 0, len(request.Name))
-	for _, name := range request.Name {
-		resolved, _, err := resolvePath(s.Sandboxes, name, false)
+	result := make([]resolvedPair, len(request.Entries))
+	for i, entry := range request.Entries {
+		var source string
+		var sourceSandbox *Sandbox
+		var err error
+		if move {
+			source, sourceSandbox, err = resolvePathNoFollow(s.Sandboxes, entry.GetSource(), true)
+			if err == nil && source == sourceSandbox.Path {
+				err = errors.BadRequestf("Can't move sandbox %s", source)
+			}
+		} else {
+			source, sourceSandbox, err = resolvePath(s.Sandboxes, entry.GetSource(), false)
+		}
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
-		var expanded []string
-		if request.GetExpand() {
-			expanded, err = filepath.Glob(resolved)
-			if err != nil {
-				return errors.Annotatef(err, "filepath.Glob(%q)", resolved)
-			}
+		destination, sandbox, err := resolvePathNoFollow(s.Sandboxes, entry.GetDestination(), true)
+		if err != nil {
+			return nil, nil, err
+		}
+		if sourceSandbox != nil {
+			sandboxes = append(sandboxes, sourceSandbox)
+		}
+		sandboxes = append(sandboxes, sandbox)
+		result[i] = resolvedPair{source: source, destination: destination, sandbox: sandbox}
+	}
+
+	unlock, err := s.lockLeased(sandboxes, request.GetLease())
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, unlock, nil
+}
+
+// Same for the single paths.
+func (s *Contester) resolveEntries(request *contester_proto.RepeatedStringEntries, follow bool) ([]string, []*Sandbox, func(), error) {
+	paths := make([]string, len(request.Entries))
+	sandboxes := make([]*Sandbox, len(request.Entries))
+	for i, entry := range request.Entries {
+		var err error
+		if follow {
+			paths[i], sandboxes[i], err = resolvePath(s.Sandboxes, entry, true)
 		} else {
-			expanded = []string{resolved}
+			paths[i], sandboxes[i], err = resolvePathNoFollow(s.Sandboxes, entry, true)
 		}
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	unlock, err := s.lockLeased(sandboxes, request.GetLease())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return paths, sandboxes, unlock, nil
+}
+
+// Give the whole tree to the sandbox owner.
+func ownTree(root string, sandbox *Sandbox) error {
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		return sandbox.Own(path)
+	})
+}
 
-		for _, name := range expanded {
-			stat, err := tools.StatFile(name, request.GetCalculateChecksum())
-			if err != nil {
+func copyFile(source, destination string, sandbox *Sandbox) (*contester_proto.FileStat, error) {
+	src, err := os.Open(source)
+	if err != nil {
+		return nil, errors.Annotatef(err, "os.Open(%q)", source)
+	}
+	defer src.Close()
+
+	info, err := src.Stat()
+	if err != nil {
+		return nil, errors.Annotate(err, "src.Stat")
+	}
+	if !info.Mode().IsRegular() {
+		return nil, errors.BadRequestf("%s is not a regular file", source)
+	}
+	mtime := info.ModTime()
+	return writeAtomically(destination, src, info.Mode().Perm(), &mtime, sandbox)
+}
+
+// Copy files, keeping their mode and modification time.
+func (s *Contester) Copy(request *contester_proto.RepeatedNamePairEntries, response *contester_proto.FileStats) error {
+	pairs, unlock, err := s.resolvePairs(request, false)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	response.Entries = make([]*contester_proto.FileStat, 0, len(pairs))
+	for _, pair := range pairs {
+		stat, err := copyFile(pair.source, pair.destination, pair.sandbox)
+		if err != nil {
+			return err
+		}
+		response.Entries = append(response.Entries, stat)
+	}
+	return nil
+}
+
+// Move files or directories between sandboxes. Files are copied if they can't be renamed,
+// e.g. when sandboxes are on different filesystems.
+func (s *Contester) Move(request *contester_proto.RepeatedNamePairEntries, response *contester_proto.FileStats) error {
+	pairs, unlock, err := s.resolvePairs(request, true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	response.Entries = make([]*contester_proto.FileStat, 0, len(pairs))
+	for _, pair := range pairs {
+		if err = os.Rename(pair.source, pair.destination); err != nil {
+			info, serr := os.Lstat(pair.source)
+			if serr != nil || !info.Mode().IsRegular() {
+				return errors.Annotatef(err, "os.Rename(%q, %q)", pair.source, pair.destination)
+			}
+			if _, err = copyFile(pair.source, pair.destination, pair.sandbox); err != nil {
 				return err
 			}
-			if stat != nil {
-				response.Entries = append(response.Entries, stat)
+			if err = os.Remove(pair.source); err != nil {
+				return errors.Annotate(err, "os.Remove")
 			}
 		}
+		if err = ownTree(pair.destination, pair.sandbox); err != nil {
+			return err
+		}
+		stat, err := tools.StatFile(pair.destination, "")
+		if err != nil {
+			return err
+		}
+		if stat != nil {
+			response.Entries = append(response.Entries, stat)
+		}
+	}
+	return nil
+}
 
+// Remove files or directories, recursively. Sandboxes themselves can't be removed, use Clear.
+func (s *Contester) Remove(request *contester_proto.RepeatedStringEntries, response *contester_proto.EmptyMessage) error {
+	paths, sandboxes, unlock, err := s.resolveEntries(request, false)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	for i, path := range paths {
+		if path == sandboxes[i].Path {
+			return errors.BadRequestf("Can't remove sandbox %s", path)
+		}
+		if err = os.RemoveAll(path); err != nil {
+			return errors.Annotatef(err, "os.RemoveAll(%q)", path)
+		}
+	}
+	return nil
+}
+
+// Create directories with all the missing parents, owned by the sandbox.
+func (s *Contester) Mkdir(request *contester_proto.RepeatedStringEntries, response *contester_proto.EmptyMessage) error {
+	paths, sandboxes, unlock, err := s.resolveEntries(request, true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	for i, path := range paths {
+		if err = makeDirs(path, sandboxes[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MkdirAll in the sandbox, giving the created directories the mode and the owner of the
+// sandbox, same as setAcl does.
+func makeDirs(path string, sandbox *Sandbox) error {
+	// Directories to be created, topmost last.
+	var created []string
+	for dir := path; dir != sandbox.Path && dir != filepath.Dir(dir); dir = filepath.Dir(dir) {
+		if _, err := os.Lstat(dir); err == nil {
+			break
+		}
+		created = append(created, dir)
+	}
+	mode := sandbox.dirMode()
+	if err := os.MkdirAll(path, mode); err != nil {
+		return errors.Annotatef(err, "os.MkdirAll(%q)", path)
+	}
+	for i := len(created) - 1; i >= 0; i-- {
+		// MkdirAll is subject to umask.
+		if err := os.Chmod(created[i], mode); err != nil {
+			return errors.Annotate(err, "os.Chmod")
+		}
+		if err := sandbox.Own(created[i]); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -0,0 +1,86 @@
+// +build linux
+
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/taskcluster/runlib/contester_proto"
+)
+
+func pairs(names ...string) *contester_proto.RepeatedNamePairEntries {
+	result := &contester_proto.RepeatedNamePairEntries{}
+	for i := 0; i < len(names); i += 2 {
+		result.Entries = append(result.Entries, &contester_proto.NamePair{
+			Source:      proto.String(names[i]),
+			Destination: proto.String(names[i+1]),
+		})
+	}
+	return result
+}
+
+func TestFileOperations(t *testing.T) {
+	c, _, cleanup := newTestContester(t, nil)
+	defer cleanup()
+
+	compile, run := c.Sandboxes[0].Compile.Path, c.Sandboxes[0].Run.Path
+	if err := ioutil.WriteFile(filepath.Join(compile, "solution"), []byte("binary"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	var stats contester_proto.FileStats
+	if err := c.Copy(pairs("%0.C/solution", "%0.R/copy"), &stats); err != nil {
+		t.Fatal(err)
+	}
+	if len(stats.Entries) != 1 || stats.Entries[0].GetSize_() != 6 {
+		t.Errorf("Unexpected copy result %v", &stats)
+	}
+	if info, err := os.Stat(filepath.Join(run, "copy")); err != nil || info.Mode() != 0755 {
+		t.Errorf("Copy doesn't keep the mode: %v", err)
+	}
+
+	if err := c.Move(pairs("%0.C/solution", "%0.R/moved"), &stats); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(compile, "solution")); !os.IsNotExist(err) {
+		t.Errorf("Source is still there after Move: %v", err)
+	}
+	if data, err := ioutil.ReadFile(filepath.Join(run, "moved")); err != nil || string(data) != "binary" {
+		t.Errorf("Moved file has %q (%v)", data, err)
+	}
+
+	c.Sandboxes[0].Run.Owner.Mode = 0750
+	dirs := &contester_proto.RepeatedStringEntries{Entries: []string{"%0.R/a/b/c"}}
+	if err := c.Mkdir(dirs, &contester_proto.EmptyMessage{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, dir := range []string{"a", "a/b", "a/b/c"} {
+		if info, err := os.Stat(filepath.Join(run, dir)); err != nil || info.Mode() != os.ModeDir|0750 {
+			t.Errorf("Mkdir created %s as %v (%v)", dir, info, err)
+		}
+	}
+
+	if err := os.Symlink("moved", filepath.Join(run, "link")); err != nil {
+		t.Fatal(err)
+	}
+	removed := &contester_proto.RepeatedStringEntries{Entries: []string{"%0.R/a", "%0.R/link"}}
+	if err := c.Remove(removed, &contester_proto.EmptyMessage{}); err != nil {
+		t.Fatal(err)
+	}
+	if files, _ := ioutil.ReadDir(run); len(files) != 2 {
+		t.Errorf("Expected copy and moved to be left, got %d files", len(files))
+	}
+
+	for _, name := range []string{"%0.R", "/etc/passwd"} {
+		if err := c.Remove(&contester_proto.RepeatedStringEntries{Entries: []string{name}}, &contester_proto.EmptyMessage{}); err == nil {
+			t.Errorf("Removed %s", name)
+		}
+	}
+	if err := c.Move(pairs("/etc/passwd", "%0.R/passwd"), &stats); err == nil {
+		t.Error("Moved file from outside of sandboxes")
+	}
+}
@@ -1,21 +1,85 @@
 package service
 
 import (
+	"sync"
+	"sync/atomic"
+	"time"
+
 	log "github.com/Sirupsen/logrus"
+	"github.com/golang/protobuf/proto"
 	"github.com/juju/errors"
 	"github.com/taskcluster/runlib/contester_proto"
 )
 
+// Storage transfers running at once for one GridfsCopy.
+const (
+	DEFAULT_COPY_PARALLELISM = 4
+	MAX_COPY_PARALLELISM     = 16
+)
+
+func (s *Contester) resolveItem(item *contester_proto.CopyOperation) (string, *Sandbox, error) {
+	if item.LocalFileName == nil || item.RemoteLocation == nil {
+		return "", nil, errors.BadRequestf("Both local file name and remote location are required")
+	}
+	return resolvePath(s.Sandboxes, item.GetLocalFileName(), false)
+}
+
+// Sandbox of the resolved path must be locked.
+func (s *Contester) copyItem(item *contester_proto.CopyOperation, resolved string, sandbox *Sandbox, lease string) (*contester_proto.FileStat, error) {
+	err := s.checkPathLease(resolved, lease)
+	if err != nil {
+		return nil, err
+	}
+
+	var stat *contester_proto.FileStat
+	if s.DownloadCache != nil && !item.GetUpload() && item.GetChecksum() != "" {
+		stat, err = s.DownloadCache.download(s.Storage, resolved, item, sandbox)
+	} else {
+		stat, err = s.Storage.Copy(resolved, item.GetRemoteLocation(), item.GetUpload(),
+			item.GetChecksum(), item.GetModuleType(), item.GetAuthorizationToken())
+		if err == nil && !item.GetUpload() && sandbox != nil {
+			err = sandbox.Own(resolved)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if stat == nil {
+		return nil, errors.NotFoundf("%s", resolved)
+	}
+	return stat, nil
+}
+
+// Copy files to or from the storage, several at once. Every entry gets its result, and
+// the stats of the successful ones are returned in entries.
 func (s *Contester) GridfsCopy(request *contester_proto.CopyOperations, response *contester_proto.FileStats) error {
 	var sandbox *Sandbox
 	var err error
 	if request.SandboxId != nil {
-		sandbox, err = getSandboxById(s.Sandboxes, *request.SandboxId)
-		if err != nil {
+		if sandbox, err = getSandboxById(s.Sandboxes, *request.SandboxId); err != nil {
 			return err
 		}
-		sandbox.Mutex.RLock()
-		defer sandbox.Mutex.RUnlock()
+	}
+	// Entries that can't be resolved fail on their own. Sandboxes receiving downloads are
+	// written to, the others are only read.
+	resolved := make([]string, len(request.Entries))
+	targets := make([]*Sandbox, len(request.Entries))
+	resolveErrs := make([]error, len(request.Entries))
+	reading, writing := []*Sandbox{sandbox}, []*Sandbox{}
+	for i, item := range request.Entries {
+		resolved[i], targets[i], resolveErrs[i] = s.resolveItem(item)
+		if resolveErrs[i] != nil {
+			continue
+		}
+		if item.GetUpload() {
+			reading = append(reading, targets[i])
+		} else {
+			writing = append(writing, targets[i])
+		}
+	}
+	defer lockSandboxesFor(reading, writing)()
+	if err = s.checkLease(sandbox, request.GetLease()); err != nil {
+		return err
 	}
 
 	s.mu.RLock()
@@ -25,37 +89,64 @@ func (s *Contester) GridfsCopy(request *contester_proto.CopyOperations, response
 		return errors.BadRequestf("can't gridfs.Copy if storage isn't set")
 	}
 
-	response.Entries = make([]*contester_proto.FileStat, 0, len(request.Entries))
-	for _, item := range request.Entries {
-		if item.LocalFileName == nil || item.RemoteLocation == nil {
-			continue
-		}
-
-		resolved, _, err := resolvePath(s.Sandboxes, item.GetLocalFileName(), false)
-		if err != nil {
-			continue // TODO
+	parallelism := DEFAULT_COPY_PARALLELISM
+	if p := int(request.GetParallelism()); p > 0 {
+		parallelism = p
+		if parallelism > MAX_COPY_PARALLELISM {
+			parallelism = MAX_COPY_PARALLELISM
 		}
+	}
 
-		stat, err := s.Storage.Copy(resolved, item.GetRemoteLocation(), item.GetUpload(),
-			item.GetChecksum(), item.GetModuleType(), item.GetAuthorizationToken())
+	results := make([]*contester_proto.CopyResult, len(request.Entries))
+	stats := make([]*contester_proto.FileStat, len(request.Entries))
+	slots := make(chan struct{}, parallelism)
+	var failed int32
+	var wg sync.WaitGroup
 
-		if err != nil {
-			log.Errorf("gridfs copy error: %+v", err)
+	for i, item := range request.Entries {
+		// Taking the slot first, so that fail fast sees the failures of finished entries.
+		slots <- struct{}{}
+		if request.GetFailFast() && atomic.LoadInt32(&failed) != 0 {
+			<-slots
+			results[i] = &contester_proto.CopyResult{Status: contester_proto.CopyResult_SKIPPED.Enum()}
 			continue
 		}
 
-		if !item.GetUpload() && sandbox != nil {
-			err = sandbox.Own(resolved)
+		wg.Add(1)
+		go func(i int, item *contester_proto.CopyOperation) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			start := time.Now()
+			var stat *contester_proto.FileStat
+			err := resolveErrs[i]
+			if err == nil {
+				stat, err = s.copyItem(item, resolved[i], targets[i], request.GetLease())
+			}
+			result := &contester_proto.CopyResult{
+				DurationMicros: proto.Uint64(uint64(time.Since(start) / time.Microsecond)),
+			}
 			if err != nil {
-				log.Errorf("sandbox.Own error: %+v", err)
-				continue
+				log.Errorf("gridfs copy error: %+v", err)
+				atomic.StoreInt32(&failed, 1)
+				result.Status = contester_proto.CopyResult_FAILED.Enum()
+				result.Error = proto.String(err.Error())
+			} else {
+				result.Status = contester_proto.CopyResult_OK.Enum()
+				result.Bytes = proto.Uint64(stat.GetSize_())
+				stats[i] = stat
 			}
-		}
+			results[i] = result
+		}(i, item)
+	}
+	wg.Wait()
 
+	response.CopyResults = results
+	response.Entries = make([]*contester_proto.FileStat, 0, len(request.Entries))
+	for _, stat := range stats {
 		if stat != nil {
 			response.Entries = append(response.Entries, stat)
 		}
 	}
-
 	return nil
 }
@@ -0,0 +1,136 @@
+// +build linux
+
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/juju/errors"
+	"github.com/taskcluster/runlib/contester_proto"
+	"github.com/taskcluster/runlib/subprocess"
+	"github.com/taskcluster/runlib/tools"
+)
+
+// Slow storage that keeps track of concurrent transfers.
+type slowBackend struct {
+	mu             sync.Mutex
+	active, peak   int
+	missingObjects map[string]bool
+}
+
+func (b *slowBackend) String() string { return "slow" }
+func (b *slowBackend) Close()         {}
+
+func (b *slowBackend) Copy(localName, remoteName string, toRemote bool, checksum, moduleType, authToken string) (*contester_proto.FileStat, error) {
+	b.mu.Lock()
+	if b.active++; b.active > b.peak {
+		b.peak = b.active
+	}
+	b.mu.Unlock()
+	defer func() {
+		b.mu.Lock()
+		b.active--
+		b.mu.Unlock()
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	if b.missingObjects[remoteName] {
+		return nil, errors.NotFoundf("%s", remoteName)
+	}
+	if err := ioutil.WriteFile(localName, []byte(remoteName), 0644); err != nil {
+		return nil, err
+	}
+	return tools.StatFile(localName, "")
+}
+
+func copyOperations(c *Contester, remotes ...string) *contester_proto.CopyOperations {
+	result := &contester_proto.CopyOperations{}
+	for i, remote := range remotes {
+		result.Entries = append(result.Entries, &contester_proto.CopyOperation{
+			LocalFileName:  proto.String(c.Sandboxes[0].Run.Path + "/" + strconv.Itoa(i)),
+			RemoteLocation: proto.String(remote),
+		})
+	}
+	return result
+}
+
+func TestGridfsCopyParallel(t *testing.T) {
+	c, _, cleanup := newTestContester(t, nil)
+	defer cleanup()
+
+	backend := &slowBackend{missingObjects: map[string]bool{"missing": true}}
+	c.Storage = backend
+
+	request := copyOperations(c, "test/1", "test/2", "missing", "test/4", "test/5", "test/6", "test/7", "test/8")
+	request.Entries = append(request.Entries, &contester_proto.CopyOperation{RemoteLocation: proto.String("test/9")})
+	request.Parallelism = proto.Uint32(4)
+	var stats contester_proto.FileStats
+	if err := c.GridfsCopy(request, &stats); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stats.CopyResults) != 9 || len(stats.Entries) != 7 {
+		t.Fatalf("Expected 9 results and 7 stats, got %v", &stats)
+	}
+	for i, result := range stats.CopyResults {
+		switch i {
+		case 2, 8:
+			if result.GetStatus() != contester_proto.CopyResult_FAILED || result.GetError() == "" {
+				t.Errorf("Expected entry %d to fail, got %v", i, result)
+			}
+		default:
+			if result.GetStatus() != contester_proto.CopyResult_OK || result.GetBytes() != 6 || result.GetDurationMicros() == 0 {
+				t.Errorf("Expected entry %d to succeed, got %v", i, result)
+			}
+		}
+	}
+	if backend.peak < 2 || backend.peak > 4 {
+		t.Errorf("Expected 2 to 4 transfers at once, got %d", backend.peak)
+	}
+
+	request = copyOperations(c, "missing", "test/2", "test/3")
+	request.Parallelism = proto.Uint32(1)
+	request.FailFast = proto.Bool(true)
+	stats.Reset()
+	if err := c.GridfsCopy(request, &stats); err != nil {
+		t.Fatal(err)
+	}
+	expected := []contester_proto.CopyResult_Status{contester_proto.CopyResult_FAILED, contester_proto.CopyResult_SKIPPED, contester_proto.CopyResult_SKIPPED}
+	for i, result := range stats.CopyResults {
+		if result.GetStatus() != expected[i] {
+			t.Errorf("Expected entry %d to be %s, got %v", i, expected[i], result)
+		}
+	}
+}
+
+func TestGridfsCopyOwner(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("Changing owners needs root")
+	}
+	c, _, cleanup := newTestContester(t, nil)
+	defer cleanup()
+	c.Storage = &slowBackend{}
+
+	// No sandbox ID in the request, the owner comes from the path of the entry.
+	run := &c.Sandboxes[0].Run
+	run.Login = &subprocess.LoginInfo{Uid: 4321}
+	run.Owner.gid = 4321
+	if err := c.GridfsCopy(copyOperations(c, "test/1"), &contester_proto.FileStats{}); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(filepath.Join(run.Path, "0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st := info.Sys().(*syscall.Stat_t); st.Uid != 4321 || st.Gid != 4321 {
+		t.Errorf("Downloaded file is owned by %d:%d", st.Uid, st.Gid)
+	}
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/golang/protobuf/proto"
+	"github.com/juju/errors"
+	"github.com/taskcluster/runlib/contester_proto"
+)
+
+const (
+	DEFAULT_LEASE = 5 * time.Minute
+	MAX_LEASE     = time.Hour
+)
+
+// Reservation of a sandbox pair across several requests. Requests to a leased pair
+// must carry its token.
+type lease struct {
+	Token    string
+	Expires  time.Time
+	timer    *time.Timer
+	clearing bool // expired, sandboxes are being cleared
+}
+
+func (l *lease) active() bool {
+	return l.Token != "" || l.clearing
+}
+
+func newLeaseToken() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", errors.Annotate(err, "rand.Read")
+	}
+	return hex.EncodeToString(b[:]), nil
+}
+
+func leaseDuration(micros uint64) time.Duration {
+	if micros == 0 {
+		return DEFAULT_LEASE
+	}
+	if d := time.Duration(micros) * time.Microsecond; d < MAX_LEASE {
+		return d
+	}
+	return MAX_LEASE
+}
+
+func (s *Contester) pairOf(sandbox *Sandbox) *SandboxPair {
+	for i := range s.Sandboxes {
+		if p := &s.Sandboxes[i]; &p.Compile == sandbox || &p.Run == sandbox {
+			return p
+		}
+	}
+	return nil
+}
+
+// Fails if the pair of the sandbox is leased with a different token. Must be called with
+// the sandbox locked, so that requests that got past it finish before the lease is given out.
+func (s *Contester) checkLease(sandbox *Sandbox, token string) error {
+	pair := s.pairOf(sandbox)
+	if pair == nil {
+		return nil
+	}
+	s.leaseMu.Lock()
+	defer s.leaseMu.Unlock()
+	if !pair.lease.active() {
+		return nil
+	}
+	if pair.lease.clearing || pair.lease.Token != token {
+		return errors.Unauthorizedf("Sandbox %s is leased", sandbox.Path)
+	}
+	return nil
+}
+
+// Same for the sandbox containing the path, if any.
+func (s *Contester) checkPathLease(path, token string) error {
+	sandbox, err := getSandboxByPath(s.Sandboxes, path)
+	if err != nil {
+		return nil
+	}
+	return s.checkLease(sandbox, token)
+}
+
+// Lock the sandboxes and check their leases. Call the returned function to unlock them.
+func (s *Contester) lockLeased(sandboxes []*Sandbox, token string) (func(), error) {
+	return s.checkLocked(sandboxes, token, lockSandboxes(sandboxes))
+}
+
+// Same with read locks.
+func (s *Contester) rlockLeased(sandboxes []*Sandbox, token string) (func(), error) {
+	return s.checkLocked(sandboxes, token, rlockSandboxes(sandboxes))
+}
+
+func (s *Contester) checkLocked(sandboxes []*Sandbox, token string, unlock func()) (func(), error) {
+	for _, sandbox := range sandboxes {
+		if err := s.checkLease(sandbox, token); err != nil {
+			unlock()
+			return nil, err
+		}
+	}
+	return unlock, nil
+}
+
+func (s *Contester) findLease(token string) (int, error) {
+	if token == "" {
+		return 0, errors.BadRequestf("Empty lease token")
+	}
+	for i := range s.Sandboxes {
+		if s.Sandboxes[i].lease.Token == token {
+			return i, nil
+		}
+	}
+	return 0, errors.NotFoundf("Lease %s", token)
+}
+
+func (s *Contester) fillLease(index int, response *contester_proto.SandboxLease) {
+	l := &s.Sandboxes[index].lease
+	response.Index = proto.Uint32(uint32(index))
+	response.Token = proto.String(l.Token)
+	response.ExpiresUnixMicros = proto.Uint64(uint64(l.Expires.UnixNano() / 1000))
+}
+
+// Must be called with leaseMu held.
+func (s *Contester) extendLease(index int, duration time.Duration) {
+	l := &s.Sandboxes[index].lease
+	l.Expires = time.Now().Add(duration)
+	if l.timer != nil {
+		l.timer.Stop()
+	}
+	token := l.Token
+	l.timer = time.AfterFunc(duration, func() { s.expireLease(index, token) })
+}
+
+// Clear the sandboxes of the expired lease, and make the pair available again.
+func (s *Contester) expireLease(index int, token string) {
+	pair := &s.Sandboxes[index]
+	s.leaseMu.Lock()
+	if pair.lease.Token != token || time.Now().Before(pair.lease.Expires) {
+		s.leaseMu.Unlock()
+		return
+	}
+	pair.lease = lease{clearing: true}
+	s.leaseMu.Unlock()
+
+	log.Infof("Lease %s on sandbox pair %d expired", token, index)
+	s.clearPair(pair)
+
+	s.leaseMu.Lock()
+	pair.lease = lease{}
+	s.leaseMu.Unlock()
+}
+
+func (s *Contester) clearPair(pair *SandboxPair) {
+	for _, sandbox := range []*Sandbox{&pair.Compile, &pair.Run} {
+		if err := clearSandbox(sandbox); err != nil {
+			log.Errorf("Clearing %s: %s", sandbox.Path, err)
+		}
+		s.dropSandboxUploads(sandbox)
+	}
+}
+
+func (s *Contester) AcquireSandbox(request *contester_proto.AcquireSandboxRequest, response *contester_proto.SandboxLease) error {
+	token, err := newLeaseToken()
+	if err != nil {
+		return err
+	}
+	index, err := s.takeLease(request, token, response)
+	if err != nil {
+		return err
+	}
+	// Requests that were let in before the lease was taken hold the sandbox locks.
+	pair := &s.Sandboxes[index]
+	lockSandboxes([]*Sandbox{&pair.Compile, &pair.Run})()
+	return nil
+}
+
+func (s *Contester) takeLease(request *contester_proto.AcquireSandboxRequest, token string, response *contester_proto.SandboxLease) (int, error) {
+	s.leaseMu.Lock()
+	defer s.leaseMu.Unlock()
+
+	index := -1
+	if request.Index != nil {
+		index = int(request.GetIndex())
+		if index >= len(s.Sandboxes) {
+			return 0, errors.BadRequestf("Sandbox index %d is out of range (max=%d)", index, len(s.Sandboxes))
+		}
+		if s.Sandboxes[index].lease.active() {
+			return 0, errors.AlreadyExistsf("Lease on sandbox pair %d", index)
+		}
+	} else {
+		for i := range s.Sandboxes {
+			if !s.Sandboxes[i].lease.active() {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return 0, errors.AlreadyExistsf("Lease on every sandbox pair")
+		}
+	}
+
+	s.Sandboxes[index].lease.Token = token
+	s.extendLease(index, leaseDuration(request.GetDurationMicros()))
+	s.fillLease(index, response)
+	return index, nil
+}
+
+func (s *Contester) RenewLease(request *contester_proto.RenewLeaseRequest, response *contester_proto.SandboxLease) error {
+	s.leaseMu.Lock()
+	defer s.leaseMu.Unlock()
+
+	index, err := s.findLease(request.GetToken())
+	if err != nil {
+		return err
+	}
+	s.extendLease(index, leaseDuration(request.GetDurationMicros()))
+	s.fillLease(index, response)
+	return nil
+}
+
+func (s *Contester) ReleaseSandbox(request *contester_proto.ReleaseSandboxRequest, response *contester_proto.EmptyMessage) error {
+	s.leaseMu.Lock()
+	index, err := s.findLease(request.GetToken())
+	if err != nil {
+		s.leaseMu.Unlock()
+		return err
+	}
+	pair := &s.Sandboxes[index]
+	pair.lease.timer.Stop()
+	if !request.GetClear() {
+		pair.lease = lease{}
+		s.leaseMu.Unlock()
+		return nil
+	}
+	pair.lease = lease{clearing: true}
+	s.leaseMu.Unlock()
+
+	s.clearPair(pair)
+
+	s.leaseMu.Lock()
+	pair.lease = lease{}
+	s.leaseMu.Unlock()
+	return nil
+}
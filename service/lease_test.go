@@ -0,0 +1,111 @@
+// +build linux
+
+package service
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/juju/errors"
+	"github.com/taskcluster/runlib/contester_proto"
+	"github.com/taskcluster/runlib/subprocess"
+)
+
+func TestLease(t *testing.T) {
+	c, _, cleanup := newTestContester(t, func(sub *subprocess.Subprocess) (*subprocess.SubprocessResult, error) {
+		return &subprocess.SubprocessResult{}, nil
+	})
+	defer cleanup()
+
+	var lease contester_proto.SandboxLease
+	if err := c.AcquireSandbox(&contester_proto.AcquireSandboxRequest{}, &lease); err != nil {
+		t.Fatal(err)
+	}
+	if lease.GetIndex() != 0 || lease.GetToken() == "" {
+		t.Fatalf("Unexpected lease %v", &lease)
+	}
+	if err := c.AcquireSandbox(&contester_proto.AcquireSandboxRequest{Index: proto.Uint32(0)}, &contester_proto.SandboxLease{}); !errors.IsAlreadyExists(err) {
+		t.Errorf("Expected already exists error, got %v", err)
+	}
+
+	var response contester_proto.LocalExecutionResult
+	if err := c.LocalExecute(testParams("%0.R"), &response); !errors.IsUnauthorized(err) {
+		t.Errorf("Expected unauthorized error without token, got %v", err)
+	}
+	request := testParams("%0.R")
+	request.Lease = lease.Token
+	if err := c.LocalExecute(request, &response); err != nil {
+		t.Error(err)
+	}
+	if err := c.LocalExecute(testParams("%1.R"), &response); err != nil {
+		t.Errorf("Unleased sandbox is not accessible: %v", err)
+	}
+
+	var renewed contester_proto.SandboxLease
+	if err := c.RenewLease(&contester_proto.RenewLeaseRequest{Token: lease.Token, DurationMicros: proto.Uint64(1000)}, &renewed); err != nil {
+		t.Fatal(err)
+	}
+	if renewed.GetToken() != lease.GetToken() {
+		t.Errorf("Token changed on renewal: %v", &renewed)
+	}
+
+	blob, _ := contester_proto.NewBlob([]byte("contents"))
+	if err := c.Put(&contester_proto.FileBlob{Name: proto.String("%0.C/file.txt"), Data: blob, Lease: lease.Token}, &contester_proto.FileStat{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Expired lease is cleared and released.
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		if err := c.LocalExecute(testParams("%0.R"), &response); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Lease didn't expire")
+		}
+	}
+	if files, _ := ioutil.ReadDir(c.Sandboxes[0].Compile.Path); len(files) != 0 {
+		t.Errorf("Sandbox is not empty after lease expiry: %d files", len(files))
+	}
+	if err := c.ReleaseSandbox(&contester_proto.ReleaseSandboxRequest{Token: lease.Token}, &contester_proto.EmptyMessage{}); !errors.IsNotFound(err) {
+		t.Errorf("Expected not found error for expired lease, got %v", err)
+	}
+}
+
+func TestLeaseWaitsForRequests(t *testing.T) {
+	started, finish := make(chan struct{}), make(chan struct{})
+	c, _, cleanup := newTestContester(t, func(sub *subprocess.Subprocess) (*subprocess.SubprocessResult, error) {
+		started <- struct{}{}
+		<-finish
+		return &subprocess.SubprocessResult{}, nil
+	})
+	defer cleanup()
+
+	executed := make(chan error, 1)
+	go func() {
+		executed <- c.LocalExecute(testParams("%0.R"), &contester_proto.LocalExecutionResult{})
+	}()
+	<-started
+
+	acquired := make(chan error, 1)
+	go func() {
+		acquired <- c.AcquireSandbox(&contester_proto.AcquireSandboxRequest{Index: proto.Uint32(0)}, &contester_proto.SandboxLease{})
+	}()
+	select {
+	case err := <-acquired:
+		t.Fatalf("Lease is given out while a request is running: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(finish)
+	if err := <-executed; err != nil {
+		t.Error(err)
+	}
+	if err := <-acquired; err != nil {
+		t.Fatal(err)
+	}
+	if err := c.LocalExecute(testParams("%0.R"), &contester_proto.LocalExecutionResult{}); !errors.IsUnauthorized(err) {
+		t.Errorf("Expected unauthorized error without token, got %v", err)
+	}
+}
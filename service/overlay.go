@@ -0,0 +1,267 @@
+package service
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/juju/errors"
+	"github.com/taskcluster/runlib/contester_proto"
+)
+
+// Overlay sandbox: Path is an overlay mount of the sandbox's own upper layer over the
+// shared read-only base. Upper and work directories are kept in Layers.
+type overlay struct {
+	Base   *overlayBase
+	Layers string
+
+	snapshot string // snapshot layer the sandbox is mounted with
+	mounted  bool
+}
+
+// Shared lower layers: the configured base directory, and the latest snapshot layer on top
+// of it. Published layers are never modified; every snapshot makes a new one, and sandboxes
+// switch to it when they are remounted. Layers are kept in Dir.snapshots/<version>.
+type overlayBase struct {
+	Dir string
+
+	mu      sync.Mutex
+	current string         // latest snapshot layer, empty if there's none
+	version int            // of the current layer
+	refs    map[string]int // mounted sandboxes by layer
+}
+
+// Pick up the latest snapshot left from the previous start, removing older ones.
+func newOverlayBase(dir string) (*overlayBase, error) {
+	b := &overlayBase{Dir: dir, refs: make(map[string]int)}
+	if err := os.MkdirAll(b.snapshots(), 0755); err != nil {
+		return nil, errors.Annotate(err, "MkdirAll")
+	}
+	names, err := ioutil.ReadDir(b.snapshots())
+	if err != nil {
+		return nil, errors.Annotate(err, "ReadDir")
+	}
+	for _, v := range names {
+		if version, err := strconv.Atoi(v.Name()); err == nil && v.IsDir() && version > b.version {
+			b.version = version
+		}
+	}
+	if b.version > 0 {
+		b.current = b.layer(b.version)
+	}
+	for _, v := range names {
+		if path := filepath.Join(b.snapshots(), v.Name()); path != b.current {
+			os.RemoveAll(path)
+		}
+	}
+	return b, nil
+}
+
+func (b *overlayBase) snapshots() string {
+	return b.Dir + ".snapshots"
+}
+
+func (b *overlayBase) layer(version int) string {
+	return filepath.Join(b.snapshots(), strconv.Itoa(version))
+}
+
+// Value of lowerdir mount option for the snapshot layer.
+func (b *overlayBase) lowerdir(layer string) string {
+	if layer == "" {
+		return b.Dir
+	}
+	return layer + ":" + b.Dir
+}
+
+// Pin the current snapshot layer for a sandbox being mounted.
+func (b *overlayBase) acquire() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refs[b.current]++
+	return b.current
+}
+
+// Unpin the layer once the sandbox is unmounted, removing it if it's outdated and unused.
+func (b *overlayBase) release(layer string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refs[layer]--
+	b.removeUnused(layer)
+}
+
+// Must be called with b.mu held.
+func (b *overlayBase) removeUnused(layer string) {
+	if layer == "" || layer == b.current || b.refs[layer] > 0 {
+		return
+	}
+	delete(b.refs, layer)
+	if err := os.RemoveAll(layer); err != nil {
+		log.Errorf("Removing old snapshot layer: %s", err)
+	}
+}
+
+// Publish a new snapshot layer: the current one, hardlinked, with the file added.
+func (b *overlayBase) snapshot(name string, src io.Reader, mode os.FileMode) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	next := b.layer(b.version + 1)
+	os.RemoveAll(next)
+	if err := linkTree(b.current, next); err != nil {
+		os.RemoveAll(next)
+		return err
+	}
+	destination, err := snapshotPath(next, name)
+	if err == nil {
+		err = writeSnapshot(destination, src, mode)
+	}
+	if err != nil {
+		os.RemoveAll(next)
+		return err
+	}
+
+	previous := b.current
+	b.current, b.version = next, b.version+1
+	b.removeUnused(previous)
+	return nil
+}
+
+// Recreate the tree with hardlinks to its files. Missing source is an empty tree.
+func linkTree(source, destination string) error {
+	if err := os.Mkdir(destination, 0755); err != nil {
+		return errors.Annotate(err, "Mkdir")
+	}
+	if source == "" {
+		return nil
+	}
+	return filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(source, path)
+		if err != nil || rel == "." {
+			return err
+		}
+		target := filepath.Join(destination, rel)
+		if info.IsDir() {
+			return errors.Annotate(os.Mkdir(target, info.Mode().Perm()), "Mkdir")
+		}
+		return errors.Annotate(os.Link(path, target), "Link")
+	})
+}
+
+func (o *overlay) upper() string {
+	return filepath.Join(o.Layers, "upper")
+}
+
+func (o *overlay) work() string {
+	return filepath.Join(o.Layers, "work")
+}
+
+// Directory holding the files created in the sandbox.
+func (s *Sandbox) ownPath() string {
+	if s.Overlay != nil {
+		return s.Overlay.upper()
+	}
+	return s.Path
+}
+
+// Directory to put the size-limited filesystem on.
+func (s *Sandbox) storagePath() string {
+	if s.Overlay != nil {
+		return s.Overlay.Layers
+	}
+	return s.Path
+}
+
+// Drop everything created in the sandbox, without walking it.
+func (s *Sandbox) resetOverlay() error {
+	if err := s.unmountOverlay(); err != nil {
+		return err
+	}
+	if err := s.resetLayers(); err != nil {
+		return err
+	}
+	return s.mountOverlay()
+}
+
+func snapshotPath(base, name string) (string, error) {
+	clean := filepath.Clean(name)
+	if name == "" || filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(os.PathSeparator)) {
+		return "", errors.BadRequestf("Invalid snapshot destination %q", name)
+	}
+	return filepath.Join(base, clean), nil
+}
+
+// Copy the file from a sandbox into a new version of the overlay base. Sandboxes see it
+// after their next Clear.
+func (s *Contester) Snapshot(request *contester_proto.SnapshotRequest, response *contester_proto.EmptyMessage) error {
+	if s.OverlayBase == nil {
+		return errors.NotSupportedf("Snapshots without overlay sandboxes")
+	}
+	source, sandbox, err := resolvePath(s.Sandboxes, request.GetSource(), true)
+	if err != nil {
+		return err
+	}
+	if _, err = snapshotPath(s.OverlayBase.Dir, request.GetDestination()); err != nil {
+		return err
+	}
+
+	sandbox.Mutex.RLock()
+	defer sandbox.Mutex.RUnlock()
+	if err = s.checkLease(sandbox, request.GetLease()); err != nil {
+		return err
+	}
+
+	src, err := os.Open(source)
+	if err != nil {
+		return errors.BadRequestf("Can't open snapshot source: %s", err)
+	}
+	defer src.Close()
+	st, err := src.Stat()
+	if err != nil {
+		return errors.Annotate(err, "Stat")
+	}
+	if !st.Mode().IsRegular() {
+		return errors.BadRequestf("Snapshot source %s is not a regular file", source)
+	}
+
+	mode := os.FileMode(0644)
+	if st.Mode()&0111 != 0 {
+		mode = 0755
+	}
+	return s.OverlayBase.snapshot(request.GetDestination(), src, mode)
+}
+
+func writeSnapshot(destination string, src io.Reader, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(destination), 0755); err != nil {
+		return errors.Annotate(err, "MkdirAll")
+	}
+	// Write to a temporary file first, so that the existing link is replaced, not modified.
+	tmp, err := ioutil.TempFile(filepath.Dir(destination), ".snapshot")
+	if err != nil {
+		return errors.Annotate(err, "TempFile")
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, src)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return errors.Annotate(err, "Copy")
+	}
+
+	if err = os.Chmod(tmp.Name(), mode); err != nil {
+		return errors.Annotate(err, "Chmod")
+	}
+	return errors.Annotate(os.Rename(tmp.Name(), destination), "Rename")
+}
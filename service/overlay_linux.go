@@ -0,0 +1,77 @@
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/juju/errors"
+)
+
+// Mount with the latest version of the base.
+func (s *Sandbox) mountOverlay() error {
+	o := s.Overlay
+	snapshot := o.Base.acquire()
+	err := runCommand("mount", "-t", "overlay", "overlay",
+		"-o", "lowerdir="+o.Base.lowerdir(snapshot)+",upperdir="+o.upper()+",workdir="+o.work(), s.Path)
+	if err != nil {
+		o.Base.release(snapshot)
+		return err
+	}
+	o.snapshot, o.mounted = snapshot, true
+	return nil
+}
+
+func (s *Sandbox) unmountOverlay() error {
+	mounted, err := isMountPoint(s.Path)
+	if err != nil || !mounted {
+		return err
+	}
+	// Detached lazily, so processes still holding files in the sandbox don't block it.
+	if err = syscall.Unmount(s.Path, syscall.MNT_DETACH); err != nil {
+		return errors.Annotate(err, "umount")
+	}
+	if o := s.Overlay; o.mounted {
+		o.Base.release(o.snapshot)
+		o.mounted = false
+	}
+	return nil
+}
+
+// Replace upper and work directories with empty ones, keeping the owner and mode of the
+// upper one. Old directories are removed in background.
+func (s *Sandbox) resetLayers() error {
+	o := s.Overlay
+	var st syscall.Stat_t
+	haveOwner := syscall.Stat(o.upper(), &st) == nil
+
+	trash, err := ioutil.TempDir(o.Layers, "old")
+	if err != nil {
+		return errors.Annotate(err, "TempDir")
+	}
+	for _, dir := range []string{o.upper(), o.work()} {
+		if err = os.Rename(dir, filepath.Join(trash, filepath.Base(dir))); err != nil && !os.IsNotExist(err) {
+			return errors.Annotate(err, "Rename")
+		}
+		if err = os.Mkdir(dir, 0700); err != nil {
+			return errors.Annotate(err, "Mkdir")
+		}
+	}
+	if haveOwner {
+		if err = os.Chown(o.upper(), int(st.Uid), int(st.Gid)); err != nil {
+			return errors.Annotate(err, "Chown")
+		}
+		if err = os.Chmod(o.upper(), os.FileMode(st.Mode&0777)); err != nil {
+			return errors.Annotate(err, "Chmod")
+		}
+	}
+
+	go func() {
+		if err := os.RemoveAll(trash); err != nil {
+			log.Errorf("Removing old sandbox layers: %s", err)
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,84 @@
+// +build linux
+
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func readLayerFile(t *testing.T, layer, name string) string {
+	data, err := ioutil.ReadFile(filepath.Join(layer, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestOverlayBaseSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "overlay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	base, err := newOverlayBase(filepath.Join(dir, "base"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first := base.acquire(); first != "" || base.lowerdir(first) != base.Dir {
+		t.Fatalf("Fresh base has snapshot layer %q", first)
+	}
+
+	if err = base.snapshot("bin/tool", strings.NewReader("v1"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	pinned := base.acquire()
+	if pinned == "" || base.lowerdir(pinned) != pinned+":"+base.Dir {
+		t.Fatalf("Snapshot layer %q is not mounted", pinned)
+	}
+
+	if err = base.snapshot("bin/tool", strings.NewReader("v2"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err = base.snapshot("lib/data", strings.NewReader("data"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	current := base.acquire()
+	defer base.release(current)
+
+	// Mounted layer is never modified.
+	if s := readLayerFile(t, pinned, "bin/tool"); s != "v1" {
+		t.Errorf("Pinned layer has %q, expected v1", s)
+	}
+	if s := readLayerFile(t, current, "bin/tool"); s != "v2" {
+		t.Errorf("Current layer has %q, expected v2", s)
+	}
+	if s := readLayerFile(t, current, "lib/data"); s != "data" {
+		t.Errorf("Current layer has %q, expected data", s)
+	}
+
+	// Layers in between were never mounted, and the pinned one goes once it's released.
+	if layers, _ := ioutil.ReadDir(base.snapshots()); len(layers) != 2 {
+		t.Errorf("%d snapshot layers kept, expected 2", len(layers))
+	}
+	base.release(pinned)
+	if _, err = os.Stat(pinned); !os.IsNotExist(err) {
+		t.Errorf("Released layer %s is not removed: %v", pinned, err)
+	}
+
+	if err = base.snapshot("../escape", strings.NewReader("x"), 0644); err == nil {
+		t.Errorf("Snapshot outside of the base is allowed")
+	}
+
+	restarted, err := newOverlayBase(base.Dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if layer := restarted.acquire(); layer != current {
+		t.Errorf("Restarted base uses layer %q, expected %q", layer, current)
+	}
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"github.com/juju/errors"
+)
+
+func (s *Sandbox) mountOverlay() error {
+	return errors.NotSupportedf("Overlay sandboxes")
+}
+
+func (s *Sandbox) unmountOverlay() error {
+	return errors.NotSupportedf("Overlay sandboxes")
+}
+
+func (s *Sandbox) resetLayers() error {
+	return errors.NotSupportedf("Overlay sandboxes")
+}
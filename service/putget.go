@@ -1,8 +1,14 @@
 package service
 
 import (
+	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/juju/errors"
 	"github.com/taskcluster/runlib/contester_proto"
 	"github.com/taskcluster/runlib/tools"
@@ -13,45 +19,103 @@ func (s *Contester) Put(request *contester_proto.FileBlob, response *contester_p
 	if err != nil {
 		return err
 	}
-
 	if sandbox != nil {
 		sandbox.Mutex.Lock()
 		defer sandbox.Mutex.Unlock()
 	}
+	if err = s.checkLease(sandbox, request.GetLease()); err != nil {
+		return err
+	}
+
+	var source io.Reader = strings.NewReader("")
+	if request.Data != nil {
+		// Fails at the end on checksum mismatch, so the file is never renamed into place.
+		if source, err = request.Data.VerifiedReader(); err != nil {
+			return err
+		}
+	}
+	mode := os.FileMode(DEFAULT_PUT_MODE)
+	if request.Mode != nil {
+		mode = os.FileMode(request.GetMode() & 0777)
+	}
+	var mtime *time.Time
+	if request.MtimeMicros != nil {
+		t := time.Unix(0, request.GetMtimeMicros()*1000)
+		mtime = &t
+	}
 
+	stat, err := writeAtomically(resolved, source, mode, mtime, sandbox)
+	if err != nil {
+		return err
+	}
+	if stat == nil {
+		return errors.NotFoundf("%s after Put", resolved)
+	}
+	*response = *stat
+	return nil
+}
+
+// Mode of files written by Put, if the request doesn't specify one.
+const DEFAULT_PUT_MODE = 0644
+
+// Write the file to a temporary one in the same directory, and rename it into place,
+// so readers never see it half-written.
+func writeAtomically(resolved string, source io.Reader, mode os.FileMode, mtime *time.Time, sandbox *Sandbox) (*contester_proto.FileStat, error) {
 	var destination *os.File
+	var err error
 
 	for {
-		destination, err = os.Create(resolved)
+		destination, err = ioutil.TempFile(filepath.Dir(resolved), ".put")
 		loop, err := OnOsCreateError(err)
 
 		if err != nil {
-			return errors.Annotatef(err, "os.Create(%q)", resolved)
+			return nil, errors.Annotatef(err, "ioutil.TempFile(%q)", resolved)
 		}
 		if !loop {
 			break
 		}
 	}
-	data, err := request.Data.Bytes()
-	if err != nil {
-		return err
+	tempName := destination.Name()
+	defer os.Remove(tempName)
+
+	_, err = io.Copy(destination, source)
+	if err == nil {
+		err = destination.Sync()
+	}
+	if cerr := destination.Close(); err == nil {
+		err = cerr
 	}
-	_, err = destination.Write(data)
-	destination.Close()
 	if err != nil {
-		return errors.Annotate(err, "destination.Write")
+		return nil, errors.Annotate(err, "Writing file")
+	}
+
+	if err = os.Chmod(tempName, mode); err != nil {
+		return nil, errors.Annotate(err, "os.Chmod")
+	}
+	if mtime != nil {
+		if err = os.Chtimes(tempName, *mtime, *mtime); err != nil {
+			return nil, errors.Annotate(err, "os.Chtimes")
+		}
 	}
 	if sandbox != nil {
-		return sandbox.Own(resolved)
+		if err = sandbox.Own(tempName); err != nil {
+			return nil, err
+		}
 	}
 
-	stat, err := tools.StatFile(resolved, true)
-	if err != nil {
-		return err
+	for {
+		err = os.Rename(tempName, resolved)
+		loop, err := OnOsCreateError(err)
+
+		if err != nil {
+			return nil, errors.Annotatef(err, "os.Rename(%q)", resolved)
+		}
+		if !loop {
+			break
+		}
 	}
 
-	*response = *stat
-	return nil
+	return tools.StatFile(resolved, contester_proto.CHECKSUM_SHA1)
 }
 
 func (s *Contester) Get(request *contester_proto.GetRequest, response *contester_proto.FileBlob) error {
@@ -59,11 +123,13 @@ func (s *Contester) Get(request *contester_proto.GetRequest, response *contester
 	if err != nil {
 		return err
 	}
-
 	if sandbox != nil {
 		sandbox.Mutex.RLock()
 		defer sandbox.Mutex.RUnlock()
 	}
+	if err = s.checkPathLease(resolved, request.GetLease()); err != nil {
+		return err
+	}
 
 	source, err := os.Open(resolved)
 	if err != nil {
@@ -71,7 +137,18 @@ func (s *Contester) Get(request *contester_proto.GetRequest, response *contester
 	}
 	defer source.Close()
 
+	info, err := source.Stat()
+	if err != nil {
+		return errors.Annotate(err, "source.Stat")
+	}
+
 	response.Name = &resolved
-	response.Data, err = contester_proto.BlobFromStream(source)
+	response.Mode = proto.Uint32(uint32(info.Mode().Perm()))
+	response.MtimeMicros = proto.Int64(info.ModTime().UnixNano() / 1000)
+	algo := contester_proto.CHECKSUM_SHA1
+	if request.ChecksumAlgorithm != nil {
+		algo = request.GetChecksumAlgorithm()
+	}
+	response.Data, err = contester_proto.DigestBlobFromStream(source, algo)
 	return err
 }
@@ -0,0 +1,309 @@
+// +build linux
+
+package service
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/juju/errors"
+	"github.com/taskcluster/runlib/contester_proto"
+)
+
+func TestPutGetClear(t *testing.T) {
+	c, _, cleanup := newTestContester(t, nil)
+	defer cleanup()
+
+	blob, err := contester_proto.NewBlob([]byte("contents"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Put(&contester_proto.FileBlob{Name: proto.String("%1.C/file.txt"), Data: blob}, &contester_proto.FileStat{}); err != nil {
+		t.Fatal(err)
+	}
+
+	var response contester_proto.FileBlob
+	if err = c.Get(&contester_proto.GetRequest{Name: proto.String(c.Sandboxes[1].Compile.Path + "/file.txt")}, &response); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := response.Data.Bytes(); string(data) != "contents" {
+		t.Errorf("Expected %q, got %q", "contents", data)
+	}
+
+	if err = c.Put(&contester_proto.FileBlob{Name: proto.String("/outside/file.txt"), Data: blob}, &contester_proto.FileStat{}); err == nil {
+		t.Error("Put outside of sandboxes succeeded")
+	}
+
+	if err = c.Clear(&contester_proto.ClearSandboxRequest{Sandbox: proto.String("%1.C")}, &contester_proto.EmptyMessage{}); err != nil {
+		t.Fatal(err)
+	}
+	if files, _ := ioutil.ReadDir(c.Sandboxes[1].Compile.Path); len(files) != 0 {
+		t.Errorf("Sandbox is not empty after Clear: %d files", len(files))
+	}
+}
+
+func TestPutModeAndTime(t *testing.T) {
+	c, _, cleanup := newTestContester(t, nil)
+	defer cleanup()
+
+	blob, err := contester_proto.NewBlob([]byte("#!/bin/sh\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mtime := time.Date(2015, 3, 1, 12, 0, 0, 0, time.UTC)
+	var stat contester_proto.FileStat
+	err = c.Put(&contester_proto.FileBlob{
+		Name:        proto.String("%0.C/run.sh"),
+		Data:        blob,
+		Mode:        proto.Uint32(0755),
+		MtimeMicros: proto.Int64(mtime.UnixNano() / 1000),
+	}, &stat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stat.GetSize_() != 10 || stat.GetChecksum() == "" {
+		t.Errorf("Unexpected stat %v", &stat)
+	}
+
+	var response contester_proto.FileBlob
+	if err = c.Get(&contester_proto.GetRequest{Name: proto.String("%0.C/run.sh")}, &response); err != nil {
+		t.Fatal(err)
+	}
+	if response.GetMode() != 0755 || response.GetMtimeMicros() != mtime.UnixNano()/1000 {
+		t.Errorf("Unexpected mode %o and mtime %d", response.GetMode(), response.GetMtimeMicros())
+	}
+	if files, _ := ioutil.ReadDir(c.Sandboxes[0].Compile.Path); len(files) != 1 {
+		t.Errorf("Expected only the file in the sandbox, got %d entries", len(files))
+	}
+}
+
+func TestPutGetChunks(t *testing.T) {
+	c, _, cleanup := newTestContester(t, nil)
+	defer cleanup()
+
+	contents := bytes.Repeat([]byte("0123456789"), 1000)
+	sum := sha1.Sum(contents)
+	name := proto.String("%0.R/big.txt")
+
+	put := func(offset, end int, final bool, checksum []byte) error {
+		blob, err := contester_proto.NewBlob(contents[offset:end])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c.PutChunk(&contester_proto.PutChunkRequest{
+			Name:   name,
+			Offset: proto.Uint64(uint64(offset)),
+			Data:   blob,
+			Final:  proto.Bool(final),
+			Sha1:   checksum,
+		}, &contester_proto.FileStat{})
+	}
+
+	if err := put(0, 4000, false, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := put(5000, 6000, false, nil); !errors.IsBadRequest(err) {
+		t.Errorf("Expected bad request for out of order chunk, got %v", err)
+	}
+	if err := put(4000, 8000, false, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := put(8000, len(contents), true, sum[:]); err != nil {
+		t.Fatal(err)
+	}
+
+	var result bytes.Buffer
+	for offset := uint64(0); ; {
+		var chunk contester_proto.FileChunk
+		if err := c.GetChunk(&contester_proto.GetChunkRequest{Name: name, Offset: proto.Uint64(offset), Length: proto.Uint64(3000)}, &chunk); err != nil {
+			t.Fatal(err)
+		}
+		data, err := chunk.Data.VerifiedBytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		result.Write(data)
+		offset += uint64(len(data))
+		if chunk.GetEof() {
+			if !bytes.Equal(chunk.Sha1, sum[:]) || chunk.GetFileSize() != uint64(len(contents)) {
+				t.Errorf("Unexpected last chunk %v", &chunk)
+			}
+			break
+		}
+	}
+	if !bytes.Equal(result.Bytes(), contents) {
+		t.Errorf("Got %d bytes back, expected %d", result.Len(), len(contents))
+	}
+	if len(c.downloads) != 0 {
+		t.Errorf("%d finished downloads are kept", len(c.downloads))
+	}
+	if info, err := os.Stat(filepath.Join(c.Sandboxes[0].Run.Path, "big.txt")); err != nil || info.Mode().Perm() != DEFAULT_PUT_MODE {
+		t.Errorf("Uploaded file is %v (%v)", info, err)
+	}
+
+	// Not read from the start, so the whole file SHA1 is unknown.
+	var chunk contester_proto.FileChunk
+	if err := c.GetChunk(&contester_proto.GetChunkRequest{Name: name, Offset: proto.Uint64(5000)}, &chunk); err != nil {
+		t.Fatal(err)
+	}
+	if !chunk.GetEof() || chunk.Sha1 != nil {
+		t.Errorf("Unexpected last chunk %v", &chunk)
+	}
+
+	if err := put(0, 10, true, sum[:]); !errors.IsBadRequest(err) {
+		t.Errorf("Expected bad request for checksum mismatch, got %v", err)
+	}
+}
+
+func TestChecksumAlgorithms(t *testing.T) {
+	c, _, cleanup := newTestContester(t, nil)
+	defer cleanup()
+
+	contents := []byte("contents")
+	for _, algo := range []string{contester_proto.CHECKSUM_SHA1, contester_proto.CHECKSUM_SHA256, contester_proto.CHECKSUM_BLAKE3} {
+		blob, err := contester_proto.NewDigestBlob(contents, algo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (blob.Digest == nil) != (algo == contester_proto.CHECKSUM_SHA1) || blob.Sha1 == nil {
+			t.Errorf("Unexpected checksums of %s blob: %v", algo, blob)
+		}
+		name := "%0.C/" + algo
+		if err = c.Put(&contester_proto.FileBlob{Name: proto.String(name), Data: blob}, &contester_proto.FileStat{}); err != nil {
+			t.Fatal(err)
+		}
+
+		var stats contester_proto.FileStats
+		err = c.Stat(&contester_proto.StatRequest{
+			Name:              []string{name},
+			CalculateChecksum: proto.Bool(true),
+			ChecksumAlgorithm: proto.String(algo),
+		}, &stats)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(stats.Entries) != 1 || stats.Entries[0].GetChecksum() != blob.Checksum() {
+			t.Errorf("Expected checksum %s, got %v", blob.Checksum(), &stats)
+		}
+
+		var response contester_proto.FileBlob
+		err = c.Get(&contester_proto.GetRequest{Name: proto.String(name), ChecksumAlgorithm: proto.String(algo)}, &response)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if response.Data.Checksum() != blob.Checksum() {
+			t.Errorf("Expected digest %s, got %s", blob.Checksum(), response.Data.Checksum())
+		}
+	}
+
+	blob, err := contester_proto.NewDigestBlob(contents, contester_proto.CHECKSUM_SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blob.Sha1 = nil
+	blob.Digest = proto.String(contester_proto.CHECKSUM_SHA256 + ":00")
+	if err = c.Put(&contester_proto.FileBlob{Name: proto.String("%0.C/corrupt"), Data: blob}, &contester_proto.FileStat{}); !errors.IsNotValid(errors.Cause(err)) {
+		t.Errorf("Expected digest mismatch, got %v", err)
+	}
+	if _, err = os.Stat(filepath.Join(c.Sandboxes[0].Compile.Path, "corrupt")); !os.IsNotExist(err) {
+		t.Errorf("Corrupt file was written: %v", err)
+	}
+
+	err = c.Stat(&contester_proto.StatRequest{
+		Name:              []string{"%0.C/sha1"},
+		CalculateChecksum: proto.Bool(true),
+		ChecksumAlgorithm: proto.String("md5"),
+	}, &contester_proto.FileStats{})
+	if !errors.IsNotSupported(err) {
+		t.Errorf("Expected unsupported algorithm, got %v", err)
+	}
+}
+
+func TestChunkChecksums(t *testing.T) {
+	c, _, cleanup := newTestContester(t, nil)
+	defer cleanup()
+
+	contents := []byte("0123456789")
+	name := proto.String("%0.R/big.txt")
+	put := func(blob *contester_proto.Blob, checksum string) error {
+		request := &contester_proto.PutChunkRequest{Name: name, Data: blob, Final: proto.Bool(true)}
+		if checksum != "" {
+			request.Checksum = proto.String(checksum)
+		}
+		return c.PutChunk(request, &contester_proto.FileStat{})
+	}
+
+	for _, algo := range []string{contester_proto.CHECKSUM_SHA1, contester_proto.CHECKSUM_SHA256, contester_proto.CHECKSUM_BLAKE3} {
+		blob, err := contester_proto.NewDigestBlob(contents, algo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = put(blob, blob.Checksum()); err != nil {
+			t.Errorf("%s: %v", algo, err)
+		}
+		if err = put(blob, algo+":00"); !errors.IsBadRequest(err) {
+			t.Errorf("%s: expected bad request for checksum mismatch, got %v", algo, err)
+		}
+	}
+
+	blob, err := contester_proto.NewDigestBlob(contents, contester_proto.CHECKSUM_SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blob.Sha1 = nil
+	blob.Digest = proto.String(contester_proto.CHECKSUM_SHA256 + ":00")
+	if err = put(blob, ""); !errors.IsNotValid(errors.Cause(err)) {
+		t.Errorf("Expected chunk digest mismatch, got %v", err)
+	}
+	if err = put(nil, "md5:00"); !errors.IsNotSupported(err) {
+		t.Errorf("Expected unsupported algorithm, got %v", err)
+	}
+}
+
+func TestAbandonedChunks(t *testing.T) {
+	c, _, cleanup := newTestContester(t, nil)
+	defer cleanup()
+
+	put := func(offset int) error {
+		blob, err := contester_proto.NewBlob([]byte("0123456789"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c.PutChunk(&contester_proto.PutChunkRequest{
+			Name:   proto.String("%0.R/big.txt"),
+			Offset: proto.Uint64(uint64(offset)),
+			Data:   blob,
+		}, &contester_proto.FileStat{})
+	}
+
+	if err := put(0); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Clear(&contester_proto.ClearSandboxRequest{Sandbox: proto.String("%0.R")}, &contester_proto.EmptyMessage{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := put(10); !errors.IsBadRequest(err) {
+		t.Errorf("Expected bad request for upload into the cleared sandbox, got %v", err)
+	}
+
+	if err := put(0); err != nil {
+		t.Fatal(err)
+	}
+	c.uploadMu.Lock()
+	for _, u := range c.uploads {
+		u.updated = u.updated.Add(-UPLOAD_TIMEOUT - time.Second)
+	}
+	c.uploadMu.Unlock()
+	if err := put(10); !errors.IsBadRequest(err) {
+		t.Errorf("Expected bad request for abandoned upload, got %v", err)
+	}
+	if len(c.uploads) != 0 {
+		t.Errorf("%d abandoned uploads are kept", len(c.uploads))
+	}
+}
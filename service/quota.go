@@ -0,0 +1,84 @@
+package service
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/juju/errors"
+	"github.com/taskcluster/runlib/contester_proto"
+)
+
+const (
+	QUOTA_TMPFS = "tmpfs" // size-limited tmpfs
+	QUOTA_LOOP  = "loop"  // ext2 image mounted via loop device
+
+	// Sandbox is considered full if less than this is left.
+	QUOTA_SLACK = 64 * 1024
+)
+
+type diskUsage struct {
+	Used, Free  uint64
+	OutOfInodes bool
+}
+
+// Mount a filesystem of the given size over the sandbox storage. Loop images are
+// stored in the image file.
+func (s *Sandbox) setupQuota(method, image string, size uint64) error {
+	switch method {
+	case QUOTA_TMPFS, QUOTA_LOOP:
+	default:
+		return errors.NotValidf("Quota method %q", method)
+	}
+	if err := mountQuota(s.storagePath(), method, image, size); err != nil {
+		return errors.Annotatef(err, "Setting up quota for %s", s.Path)
+	}
+	s.Quota = size
+	return nil
+}
+
+func (s *Sandbox) fillDiskUsage() *contester_proto.DiskUsage {
+	if s.Quota == 0 {
+		return nil
+	}
+	result := &contester_proto.DiskUsage{
+		Quota: proto.Uint64(s.Quota),
+	}
+	if usage, err := getDiskUsage(s.Path); err == nil {
+		result.Used = proto.Uint64(usage.Used)
+	}
+	return result
+}
+
+func (u diskUsage) full() bool {
+	return u.Free < QUOTA_SLACK || u.OutOfInodes
+}
+
+// Run has filled the sandbox. If it was full already, only if the run managed to use more.
+func quotaExceeded(before, after diskUsage) bool {
+	return after.full() && (!before.full() || after.Used > before.Used)
+}
+
+// Disk usage of the sandbox before the run, nil if it has no quota.
+func (s *Sandbox) diskUsageBefore() *diskUsage {
+	if s.Quota == 0 {
+		return nil
+	}
+	usage, err := getDiskUsage(s.Path)
+	if err != nil {
+		return nil
+	}
+	return &usage
+}
+
+// Flag the result if the process has run out of space in the sandbox.
+func (s *Sandbox) fillQuotaExceeded(before *diskUsage, response *contester_proto.LocalExecutionResult) {
+	if before == nil {
+		return
+	}
+	usage, err := getDiskUsage(s.Path)
+	if err != nil || !quotaExceeded(*before, usage) {
+		return
+	}
+	if response.Flags == nil {
+		response.Flags = &contester_proto.ExecutionResultFlags{}
+	}
+	response.Flags.DiskQuotaExceeded = proto.Bool(true)
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"syscall"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/juju/errors"
+)
+
+// Filesystem types reported by statfs.
+const (
+	TMPFS_MAGIC      = 0x01021994
+	EXT2_SUPER_MAGIC = 0xef53
+)
+
+func isMountPoint(path string) (bool, error) {
+	var st, parent syscall.Stat_t
+	if err := syscall.Stat(path, &st); err != nil {
+		return false, errors.Annotate(err, "stat")
+	}
+	if err := syscall.Stat(filepath.Dir(path), &parent); err != nil {
+		return false, errors.Annotate(err, "stat")
+	}
+	return st.Dev != parent.Dev, nil
+}
+
+func runCommand(name string, args ...string) error {
+	if out, err := exec.Command(name, args...).CombinedOutput(); err != nil {
+		return errors.Annotatef(err, "%s %v: %s", name, args, out)
+	}
+	return nil
+}
+
+func createImage(image string, size uint64) error {
+	if err := os.Remove(image); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	f, err := os.OpenFile(image, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	err = f.Truncate(int64(size))
+	f.Close()
+	if err != nil {
+		return err
+	}
+	return runCommand("mkfs.ext2", "-q", "-F", "-m", "0", image)
+}
+
+func mountQuota(path, method, image string, size uint64) error {
+	mounted, err := isMountPoint(path)
+	if err != nil {
+		return err
+	}
+	if mounted {
+		// Left from the previous start, contents will be cleared anyway.
+		if mountedSize(path, method, image) == quotaSize(method, size) {
+			log.Infof("%s is already mounted with the same quota", path)
+			return nil
+		}
+		log.Infof("%s is mounted with a different quota, remounting", path)
+		if err = runCommand("umount", path); err != nil {
+			return err
+		}
+	}
+	switch method {
+	case QUOTA_TMPFS:
+		return runCommand("mount", "-t", "tmpfs", "-o", "size="+strconv.FormatUint(size, 10)+",mode=0755", "tmpfs", path)
+	case QUOTA_LOOP:
+		if err = createImage(image, size); err != nil {
+			return err
+		}
+		return runCommand("mount", "-o", "loop", image, path)
+	}
+	return nil
+}
+
+// Size of the filesystem mounted by mountQuota, as it reports it. Tmpfs is rounded up to pages.
+func quotaSize(method string, size uint64) uint64 {
+	if method == QUOTA_TMPFS {
+		page := uint64(os.Getpagesize())
+		return (size + page - 1) / page * page
+	}
+	return size
+}
+
+// Size of the quota mounted at path, 0 if it isn't of the given method.
+func mountedSize(path, method, image string) uint64 {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return 0
+	}
+	switch method {
+	case QUOTA_TMPFS:
+		if st.Type == TMPFS_MAGIC {
+			return st.Blocks * uint64(st.Bsize)
+		}
+	case QUOTA_LOOP:
+		// Loop filesystem doesn't report the space taken by its metadata, so the image
+		// size is compared instead.
+		if fi, err := os.Stat(image); err == nil && st.Type == EXT2_SUPER_MAGIC {
+			return uint64(fi.Size())
+		}
+	}
+	return 0
+}
+
+func getDiskUsage(path string) (diskUsage, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return diskUsage{}, errors.Annotate(err, "statfs")
+	}
+	bsize := uint64(st.Bsize)
+	return diskUsage{
+		Used:        (st.Blocks - st.Bfree) * bsize,
+		Free:        st.Bavail * bsize,
+		OutOfInodes: st.Files > 0 && st.Ffree == 0,
+	}, nil
+}
@@ -0,0 +1,30 @@
+// +build linux
+
+package service
+
+import "testing"
+
+func TestQuotaExceeded(t *testing.T) {
+	roomy := diskUsage{Used: 1000, Free: QUOTA_SLACK * 2}
+	full := diskUsage{Used: 5000, Free: QUOTA_SLACK / 2}
+	fuller := diskUsage{Used: 6000, Free: QUOTA_SLACK / 4}
+	noInodes := diskUsage{Used: 1000, Free: QUOTA_SLACK * 2, OutOfInodes: true}
+
+	for _, c := range []struct {
+		before, after diskUsage
+		expected      bool
+	}{
+		{roomy, roomy, false},
+		{roomy, full, true},
+		{roomy, noInodes, true},
+		{full, full, false},
+		{full, fuller, true},
+		{fuller, full, false},
+		{full, roomy, false},
+		{noInodes, noInodes, false},
+	} {
+		if r := quotaExceeded(c.before, c.after); r != c.expected {
+			t.Errorf("quotaExceeded(%+v, %+v) = %v, expected %v", c.before, c.after, r, c.expected)
+		}
+	}
+}
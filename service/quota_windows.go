@@ -0,0 +1,13 @@
+package service
+
+import (
+	"github.com/juju/errors"
+)
+
+func mountQuota(path, method, image string, size uint64) error {
+	return errors.NotSupportedf("Sandbox quotas")
+}
+
+func getDiskUsage(path string) (diskUsage, error) {
+	return diskUsage{}, errors.NotSupportedf("Sandbox quotas")
+}
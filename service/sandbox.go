@@ -1,8 +1,10 @@
 package service
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -11,14 +13,130 @@ import (
 	"github.com/taskcluster/runlib/subprocess"
 )
 
+// Owner and permissions applied to the files in the sandbox.
+type sandboxOwner struct {
+	User, Group string
+	Mode        os.FileMode // for directories, files get it without exec bits unless they had any
+	uid, gid    int         // resolved by setAcl
+}
+
+const DEFAULT_SANDBOX_MODE = 0700
+
+func newSandboxOwner(user, defaultUser, group, mode string, index int) (sandboxOwner, error) {
+	if user == "" {
+		user = defaultUser
+	}
+	if strings.Contains(user, "%d") {
+		user = fmt.Sprintf(user, index)
+	}
+	result := sandboxOwner{
+		User:  user,
+		Group: group,
+		Mode:  DEFAULT_SANDBOX_MODE,
+	}
+	if mode != "" {
+		m, err := strconv.ParseUint(mode, 8, 32)
+		if err != nil || m&^0777 != 0 {
+			return result, errors.NotValidf("Sandbox mode %q", mode)
+		}
+		result.Mode = os.FileMode(m)
+	}
+	return result, nil
+}
+
 type Sandbox struct {
 	Path  string
 	Mutex sync.RWMutex
 	Login *subprocess.LoginInfo
+	Quota uint64 // bytes, 0 if the sandbox size isn't limited
+	// Set if the sandbox is an overlay over the shared base.
+	Overlay *overlay
+	Owner   sandboxOwner
+}
+
+// Mode of directories in the sandbox, DEFAULT_SANDBOX_MODE if the owner isn't configured.
+func (s *Sandbox) dirMode() os.FileMode {
+	if s.Owner.Mode != 0 {
+		return s.Owner.Mode
+	}
+	return DEFAULT_SANDBOX_MODE
 }
 
 type SandboxPair struct {
 	Compile, Run Sandbox
+	lease        lease // protected by Contester.leaseMu
+}
+
+type sandboxesByPath []*Sandbox
+
+func (s sandboxesByPath) Len() int           { return len(s) }
+func (s sandboxesByPath) Less(i, j int) bool { return s[i].Path < s[j].Path }
+func (s sandboxesByPath) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// Distinct sandboxes, in the order they are locked. Nils are skipped.
+func uniqueSandboxes(sandboxes []*Sandbox) sandboxesByPath {
+	seen := make(map[*Sandbox]bool)
+	var unique sandboxesByPath
+	for _, v := range sandboxes {
+		if v != nil && !seen[v] {
+			seen[v] = true
+			unique = append(unique, v)
+		}
+	}
+	sort.Sort(unique)
+	return unique
+}
+
+// Lock each distinct sandbox once, always in the same order, so requests sharing
+// sandboxes can't deadlock. Returns the function to unlock them.
+func lockSandboxes(sandboxes []*Sandbox) func() {
+	unique := uniqueSandboxes(sandboxes)
+	for _, v := range unique {
+		v.Mutex.Lock()
+	}
+	return func() {
+		for i := len(unique) - 1; i >= 0; i-- {
+			unique[i].Mutex.Unlock()
+		}
+	}
+}
+
+// Same with read locks.
+func rlockSandboxes(sandboxes []*Sandbox) func() {
+	unique := uniqueSandboxes(sandboxes)
+	for _, v := range unique {
+		v.Mutex.RLock()
+	}
+	return func() {
+		for i := len(unique) - 1; i >= 0; i-- {
+			unique[i].Mutex.RUnlock()
+		}
+	}
+}
+
+// Write lock the sandboxes in write and read lock the rest, in the same order as above.
+func lockSandboxesFor(read, write []*Sandbox) func() {
+	writing := make(map[*Sandbox]bool)
+	for _, v := range write {
+		writing[v] = true
+	}
+	unique := uniqueSandboxes(append(append([]*Sandbox{}, read...), write...))
+	for _, v := range unique {
+		if writing[v] {
+			v.Mutex.Lock()
+		} else {
+			v.Mutex.RLock()
+		}
+	}
+	return func() {
+		for i := len(unique) - 1; i >= 0; i-- {
+			if writing[unique[i]] {
+				unique[i].Mutex.Unlock()
+			} else {
+				unique[i].Mutex.RUnlock()
+			}
+		}
+	}
 }
 
 func getSandboxById(s []SandboxPair, id string) (*Sandbox, error) {
@@ -48,19 +166,79 @@ func getSandboxById(s []SandboxPair, id string) (*Sandbox, error) {
 	return nil, errors.BadRequestf("Sandbox variant %s is unknown", parts[1])
 }
 
+// True if the path is the root or lies beneath it, comparing whole components.
+func isBeneath(root, path string) bool {
+	return path == root || strings.HasPrefix(path, root+string(os.PathSeparator))
+}
+
 func getSandboxByPath(s []SandboxPair, id string) (*Sandbox, error) {
 	cleanid := filepath.Clean(id)
-	for _, v := range s {
+	for i := range s {
+		v := &s[i]
 		switch {
-		case strings.HasPrefix(cleanid, v.Compile.Path):
+		case isBeneath(v.Compile.Path, cleanid):
 			return &v.Compile, nil
-		case strings.HasPrefix(cleanid, v.Run.Path):
+		case isBeneath(v.Run.Path, cleanid):
 			return &v.Run, nil
 		}
 	}
 	return nil, errors.BadRequestf("No sandbox corresponds to path %s", cleanid)
 }
 
+// Symlinks followed while resolving one path, same as the kernel limit.
+const MAX_SYMLINKS = 40
+
+func splitPath(name string) []string {
+	return strings.FieldsFunc(name, func(r rune) bool {
+		return r == '/' || r == os.PathSeparator
+	})
+}
+
+// Join the relative name to the root, resolving symlinks the way openat2 with
+// RESOLVE_BENEATH does: absolute links and .. above the root are rejected. Missing
+// components are joined as is.
+func secureJoin(root, name string) (string, error) {
+	root = filepath.Clean(root)
+	current := root
+	pending := splitPath(name)
+	links := 0
+	for len(pending) > 0 {
+		part := pending[0]
+		pending = pending[1:]
+		switch part {
+		case ".":
+			continue
+		case "..":
+			if current == root {
+				return "", errors.BadRequestf("Path %s escapes %s", name, root)
+			}
+			current = filepath.Dir(current)
+			continue
+		}
+
+		next := filepath.Join(current, part)
+		info, err := os.Lstat(next)
+		if err != nil || info.Mode()&os.ModeSymlink == 0 {
+			current = next
+			continue
+		}
+		if links++; links > MAX_SYMLINKS {
+			return "", errors.BadRequestf("Too many symlinks in %s", name)
+		}
+		target, err := os.Readlink(next)
+		if err != nil {
+			return "", errors.Annotate(err, "os.Readlink")
+		}
+		if filepath.IsAbs(target) || filepath.VolumeName(target) != "" {
+			return "", errors.BadRequestf("Path %s escapes %s via absolute symlink", name, root)
+		}
+		pending = append(splitPath(target), pending...)
+	}
+	return current, nil
+}
+
+// Resolve the path, given as absolute one or relative to the sandbox ID (%0.R/file). Paths
+// in sandboxes never resolve outside of them. Restricted paths must be in a sandbox.
 func resolvePath(s []SandboxPair, source string, restricted bool) (string, *Sandbox, error) {
 	if len(source) < 1 {
 		return source, nil, errors.BadRequestf("Invalid path %s", source)
@@ -73,7 +251,11 @@ func resolvePath(s []SandboxPair, source string, restricted bool) (string, *Sand
 			return source, nil, err
 		}
 		if len(parts) == 2 {
-			return filepath.Join(sandbox.Path, parts[1]), sandbox, nil
+			resolved, err := secureJoin(sandbox.Path, parts[1])
+			if err != nil {
+				return source, nil, err
+			}
+			return resolved, sandbox, nil
 		}
 		return sandbox.Path, sandbox, nil
 	}
@@ -82,13 +264,40 @@ func resolvePath(s []SandboxPair, source string, restricted bool) (string, *Sand
 		return source, nil, errors.BadRequestf("Relative path %s", source)
 	}
 
-	if restricted {
-		sandbox, err := getSandboxByPath(s, source)
-		if err != nil {
+	sandbox, err := getSandboxByPath(s, source)
+	if err != nil {
+		if restricted {
 			return source, nil, err
 		}
-		return source, sandbox, err
+		return source, nil, nil
+	}
+	rel, err := filepath.Rel(sandbox.Path, filepath.Clean(source))
+	if err != nil {
+		return source, nil, errors.Annotate(err, "filepath.Rel")
+	}
+	resolved, err := secureJoin(sandbox.Path, rel)
+	if err != nil {
+		return source, nil, err
 	}
+	return resolved, sandbox, nil
+}
 
-	return source, nil, nil
+// Expand the pattern in the resolved path. Matches may be symlinks leading out of the
+// sandbox, so each is resolved again, following the last component if follow is set.
+func globResolved(s []SandboxPair, pattern string, follow bool) ([]string, error) {
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, errors.Annotatef(err, "filepath.Glob(%q)", pattern)
+	}
+	for i, match := range matches {
+		if follow {
+			matches[i], _, err = resolvePath(s, match, false)
+		} else {
+			matches[i], _, err = resolvePathNoFollow(s, match, false)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return matches, nil
 }
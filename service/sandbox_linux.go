@@ -2,8 +2,97 @@ package service
 
 import (
 	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/juju/errors"
 )
 
 func (s *Sandbox) Own(filename string) error {
-	return os.Chown(filename, s.Login.Uid, 0)
+	// Files created by the service already belong to it.
+	if s.Login == nil || s.Login.Uid == os.Getuid() {
+		return nil
+	}
+	if info, err := os.Lstat(filename); err == nil && isHardlinked(info) {
+		return nil
+	}
+	return os.Lchown(filename, s.Login.Uid, s.Owner.gid)
+}
+
+func lookupOwner(username, group string) (int, int, error) {
+	u, err := user.Lookup(username)
+	if err != nil {
+		return 0, 0, errors.Annotate(err, "user.Lookup")
+	}
+	gidString := u.Gid
+	if group != "" {
+		g, err := user.LookupGroup(group)
+		if err != nil {
+			return 0, 0, errors.Annotate(err, "user.LookupGroup")
+		}
+		gidString = g.Gid
+	}
+	uid, err := strconv.Atoi(u.Uid)
+	if err != nil {
+		return 0, 0, errors.Annotate(err, "strconv.Atoi")
+	}
+	gid, err := strconv.Atoi(gidString)
+	if err != nil {
+		return 0, 0, errors.Annotate(err, "strconv.Atoi")
+	}
+	return uid, gid, nil
+}
+
+func fileMode(info os.FileInfo, mode os.FileMode) os.FileMode {
+	if info.IsDir() || info.Mode()&0111 != 0 {
+		return mode
+	}
+	return mode &^ 0111
+}
+
+// Recursively apply the sandbox owner and mode, without following symlinks. All failures
+// are logged, and the first few are returned.
+func setAcl(s *Sandbox) error {
+	root := s.ownPath()
+	uid, gid, err := lookupOwner(s.Owner.User, s.Owner.Group)
+	if err != nil {
+		return errors.Annotatef(err, "Owner of %s", root)
+	}
+	s.Owner.uid, s.Owner.gid = uid, gid
+
+	var failures []string
+	fail := func(err error) {
+		log.Errorf("Setting up sandbox %s: %s", root, err)
+		failures = append(failures, err.Error())
+	}
+	filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			fail(err)
+			return nil
+		}
+		if isHardlinked(info) {
+			return nil
+		}
+		if err = os.Lchown(path, uid, gid); err != nil {
+			fail(err)
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return nil
+		}
+		if err = os.Chmod(path, fileMode(info, s.Owner.Mode)); err != nil {
+			fail(err)
+		}
+		return nil
+	})
+
+	if len(failures) > 0 {
+		if len(failures) > 3 {
+			failures = append(failures[:3], strconv.Itoa(len(failures)-3)+" more")
+		}
+		return errors.Errorf("Setting up sandbox %s: %s", root, strings.Join(failures, "; "))
+	}
+	return nil
 }
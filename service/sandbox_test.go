@@ -0,0 +1,117 @@
+// +build linux
+
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/taskcluster/runlib/contester_proto"
+)
+
+func TestSetAcl(t *testing.T) {
+	base, err := ioutil.TempDir("", "contester")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(base)
+
+	root := filepath.Join(base, "R")
+	for _, v := range []struct {
+		name string
+		mode os.FileMode
+	}{{"data", 0666}, {"run", 0777}, {"outside", 0644}} {
+		dir := root
+		if v.name == "outside" {
+			dir = base
+		}
+		if err = os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(filepath.Join(dir, v.name), nil, v.mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = os.Symlink(filepath.Join(base, "outside"), filepath.Join(root, "link")); err != nil {
+		t.Fatal(err)
+	}
+
+	current, err := user.Current()
+	if err != nil {
+		t.Fatal(err)
+	}
+	owner, err := newSandboxOwner(current.Username, "", "", "0750", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sandbox := &Sandbox{Path: root, Owner: owner}
+	if err = setAcl(sandbox); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, expected := range map[string]os.FileMode{
+		"R":       os.ModeDir | 0750,
+		"R/data":  0640,
+		"R/run":   0750,
+		"outside": 0644,
+	} {
+		info, err := os.Lstat(filepath.Join(base, name))
+		if err != nil {
+			t.Error(err)
+		} else if info.Mode() != expected {
+			t.Errorf("Expected %s to have mode %s, got %s", name, expected, info.Mode())
+		}
+	}
+
+	sandbox.Owner.User = "no such user"
+	if err = setAcl(sandbox); err == nil {
+		t.Error("setAcl succeeded for unknown user")
+	}
+	if _, err = newSandboxOwner("", "tester%d", "", "0999", 1); err == nil {
+		t.Error("Invalid mode accepted")
+	}
+}
+
+func TestResolvePath(t *testing.T) {
+	c, _, cleanup := newTestContester(t, nil)
+	defer cleanup()
+
+	root := c.Sandboxes[0].Run.Path
+	if err := os.Mkdir(filepath.Join(root, "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for link, target := range map[string]string{
+		"inside":   "sub",
+		"absolute": "/etc/passwd",
+		"up":       "../C",
+		"loop":     "loop",
+	} {
+		if err := os.Symlink(target, filepath.Join(root, link)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, name := range []string{"%0.R/inside/file", root + "/inside/file", root + "/sub/../inside/file"} {
+		resolved, sandbox, err := resolvePath(c.Sandboxes, name, true)
+		if err != nil {
+			t.Errorf("Can't resolve %s: %s", name, err)
+		} else if resolved != filepath.Join(root, "sub", "file") || sandbox != &c.Sandboxes[0].Run {
+			t.Errorf("%s resolved to %s in %v", name, resolved, sandbox)
+		}
+	}
+
+	for _, name := range []string{"%0.R/absolute", "%0.R/up/file", "%0.R/../C/file", "%0.R/loop", root + "/absolute", root + "2/file"} {
+		if resolved, _, err := resolvePath(c.Sandboxes, name, true); err == nil {
+			t.Errorf("%s resolved to %s", name, resolved)
+		}
+	}
+
+	var response contester_proto.FileBlob
+	if err := c.Get(&contester_proto.GetRequest{Name: proto.String(root + "/absolute")}, &response); err == nil {
+		t.Error("Get followed symlink out of the sandbox")
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/taskcluster/runlib/platform"
 	"github.com/taskcluster/runlib/storage"
 	"github.com/taskcluster/runlib/subprocess"
+	_ "github.com/taskcluster/runlib/tools/blake3"
 	"gopkg.in/gcfg.v1"
 )
 
@@ -29,8 +30,23 @@ type Contester struct {
 
 	GData *platform.GlobalData
 
-	mu      sync.RWMutex
-	Storage storage.Backend
+	// Runs all the subprocesses, replaced in tests.
+	Executor subprocess.Executor
+
+	// Shared lower layers of overlay sandboxes, nil if they are plain directories.
+	OverlayBase *overlayBase
+
+	// Storage downloads by checksum, nil if not configured.
+	DownloadCache *downloadCache
+
+	mu               sync.RWMutex
+	leaseMu          sync.Mutex
+	uploadMu         sync.Mutex
+	uploads          map[string]*upload      // chunked uploads by path
+	downloads        map[downloadKey]*upload // chunked downloads by path and next offset
+	Storage          storage.Backend
+	speedFactor      float64
+	calibrationError string // set if the last calibration failed
 }
 
 func getHostname() string {
@@ -52,7 +68,7 @@ func getLocalEnvironment() []*contester_proto.LocalEnvironment_Variable {
 	return result
 }
 
-func configureSandboxes(config *contesterConfig) ([]SandboxPair, error) {
+func configureSandboxes(config *contesterConfig, base *overlayBase) ([]SandboxPair, error) {
 	basePath := config.Default.Path
 	passwords := getPasswords(config)
 	result := make([]SandboxPair, len(passwords))
@@ -60,42 +76,99 @@ func configureSandboxes(config *contesterConfig) ([]SandboxPair, error) {
 		localBase := filepath.Join(basePath, strconv.Itoa(index))
 		result[index].Compile.Path = filepath.Join(localBase, "C")
 		result[index].Run.Path = filepath.Join(localBase, "R")
+		pair := []*Sandbox{&result[index].Compile, &result[index].Run}
 
-		e := checkSandbox(result[index].Compile.Path)
+		for _, sandbox := range pair {
+			if e := prepareSandbox(sandbox, config, base); e != nil {
+				return nil, e
+			}
+		}
+
+		compileOwner, e := newSandboxOwner(config.Default.CompileUser, "compiler", config.Default.CompileGroup, config.Default.CompileMode, index)
 		if e != nil {
 			return nil, e
 		}
-		e = checkSandbox(result[index].Run.Path)
+		runOwner, e := newSandboxOwner(config.Default.RunUser, "tester%d", config.Default.RunGroup, config.Default.RunMode, index)
 		if e != nil {
 			return nil, e
 		}
 
 		if PLATFORM_ID == "linux" {
-			e = setAcl(result[index].Compile.Path, "compiler")
+			result[index].Compile.Owner = compileOwner
+			e = setAcl(&result[index].Compile)
 			if e != nil {
 				return nil, e
 			}
-			result[index].Compile.Login, e = subprocess.NewLoginInfo("compiler", "compiler")
+			result[index].Compile.Login, e = subprocess.NewLoginInfo(compileOwner.User, "compiler")
 			if e != nil {
 				return nil, e
 			}
 		}
 
-		restrictedUser := "tester" + strconv.Itoa(index)
-
-		e = setAcl(result[index].Run.Path, restrictedUser)
+		result[index].Run.Owner = runOwner
+		e = setAcl(&result[index].Run)
 		if e != nil {
 			return nil, e
 		}
 		// HACK HACK: on linux, passwords are ignored.
-		result[index].Run.Login, e = subprocess.NewLoginInfo(restrictedUser, password)
+		result[index].Run.Login, e = subprocess.NewLoginInfo(runOwner.User, password)
 		if e != nil {
 			return nil, e
 		}
+
+		if base != nil {
+			for _, sandbox := range pair {
+				if e = sandbox.mountOverlay(); e != nil {
+					return nil, e
+				}
+			}
+		}
 	}
 	return result, nil
 }
 
+// Create the sandbox directory and, if configured, its quota and empty overlay layers.
+func prepareSandbox(sandbox *Sandbox, config *contesterConfig, base *overlayBase) error {
+	if err := checkSandbox(sandbox.Path); err != nil {
+		return err
+	}
+
+	if base != nil {
+		sandbox.Overlay = &overlay{
+			Base:   base,
+			Layers: sandbox.Path + ".layers",
+		}
+		if err := checkSandbox(sandbox.Overlay.Layers); err != nil {
+			return err
+		}
+	}
+
+	if config.Default.SandboxQuotaMb > 0 {
+		quota := uint64(config.Default.SandboxQuotaMb) * 1024 * 1024
+		method := config.Default.SandboxQuotaMethod
+		if method == "" {
+			method = QUOTA_TMPFS
+		}
+		if err := sandbox.setupQuota(method, sandbox.Path+".img", quota); err != nil {
+			return err
+		}
+	}
+
+	if sandbox.Overlay != nil {
+		// Left from the previous start.
+		if stale, err := filepath.Glob(filepath.Join(sandbox.Overlay.Layers, "old*")); err == nil {
+			for _, v := range stale {
+				os.RemoveAll(v)
+			}
+		}
+		if err := sandbox.unmountOverlay(); err != nil {
+			return err
+		}
+		return sandbox.resetLayers()
+	}
+	return nil
+}
+
 func checkSandbox(path string) error {
 	err := os.MkdirAll(path, os.ModeDir|0755)
 	if err != nil {
@@ -108,6 +181,21 @@ type contesterConfig struct {
 	Default struct {
 		Server, Passwords, Path string
 		SandboxCount            int
+		// Size of each sandbox filesystem, not limited if 0.
+		SandboxQuotaMb     int
+		SandboxQuotaMethod string // tmpfs (default) or loop
+		// Read-only base with runtime files, sandboxes become overlays on top of it.
+		// Snapshot versions are kept next to it, in OverlayBase.snapshots.
+		OverlayBase string
+		// Owner of sandbox files: user (%d is replaced by the sandbox index), group
+		// (user's primary one by default) and octal mode of directories (0700 by default).
+		CompileUser, CompileGroup, CompileMode string
+		RunUser, RunGroup, RunMode             string
+		// Directory for the cache of storage downloads with checksums, its size (1024 by
+		// default) and whether hits may be hardlinked if reflinks aren't supported.
+		DownloadCache          string
+		DownloadCacheMb        int
+		DownloadCacheHardlinks bool
 	}
 }
 
@@ -129,13 +217,34 @@ func NewContester(configFile string, gData *platform.GlobalData) (*Contester, er
 	result.ProgramFiles = PLATFORM_PFILES
 	result.PathSeparator = string(os.PathSeparator)
 	result.GData = gData
+	result.Executor = subprocess.LocalExecutor{}
 
 	var err error
-	result.Sandboxes, err = configureSandboxes(&config)
+	if config.Default.OverlayBase != "" {
+		if result.OverlayBase, err = newOverlayBase(config.Default.OverlayBase); err != nil {
+			return nil, err
+		}
+	}
+	result.Sandboxes, err = configureSandboxes(&config, result.OverlayBase)
 	if err != nil {
 		return nil, err
 	}
 
+	if config.Default.DownloadCache != "" {
+		size := config.Default.DownloadCacheMb
+		if size <= 0 {
+			size = DEFAULT_CACHE_MB
+		}
+		result.DownloadCache, err = newDownloadCache(config.Default.DownloadCache, int64(size)*1024*1024, config.Default.DownloadCacheHardlinks)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err = result.calibrate(); err != nil {
+		log.Errorf("Host speed calibration failed: %s", err)
+	}
+
 	return &result, nil
 }
 
@@ -156,15 +265,28 @@ func (s *Contester) Identify(request *contester_proto.IdentifyRequest, response
 	response.Environment = &contester_proto.LocalEnvironment{
 		Variable: s.Env[:]}
 	response.Sandboxes = make([]*contester_proto.SandboxLocations, len(s.Sandboxes))
-	for i, p := range s.Sandboxes {
+	for i := range s.Sandboxes {
+		p := &s.Sandboxes[i]
 		response.Sandboxes[i] = &contester_proto.SandboxLocations{
-			Compile: proto.String(p.Compile.Path),
-			Run:     proto.String(p.Run.Path)}
+			Compile:     proto.String(p.Compile.Path),
+			Run:         proto.String(p.Run.Path),
+			CompileDisk: p.Compile.fillDiskUsage(),
+			RunDisk:     p.Run.fillDiskUsage(),
+		}
 	}
 	response.Platform = &s.Platform
 	response.PathSeparator = &s.PathSeparator
 	response.Disks = s.Disks
 	response.ProgramFiles = s.ProgramFiles
+	if factor := s.getSpeedFactor(); factor > 0 {
+		response.SpeedFactor = proto.Float64(factor)
+	}
+	if e := s.getCalibrationError(); e != "" {
+		response.CalibrationError = proto.String(e)
+	}
+	if s.DownloadCache != nil {
+		response.DownloadCache = s.DownloadCache.stats()
+	}
 
 	return nil
 }
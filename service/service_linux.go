@@ -1,7 +1,6 @@
 package service
 
 import (
-	"os/exec"
 	"strconv"
 )
 
@@ -23,11 +22,3 @@ func getPasswords(c *contesterConfig) []string {
 	}
 	return result
 }
-
-func setAcl(path, username string) error {
-	cmd := exec.Command("chown", "-R", username, path)
-	cmd.Run()
-	cmd = exec.Command("chmod", "-R", "0700", path)
-	cmd.Run()
-	return nil
-}
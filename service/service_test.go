@@ -0,0 +1,41 @@
+// +build linux
+
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/taskcluster/runlib/subprocess"
+)
+
+// Contester with two sandbox pairs in a temporary directory, running nothing for real.
+// Call the returned function to remove the sandboxes.
+func newTestContester(t *testing.T, script func(*subprocess.Subprocess) (*subprocess.SubprocessResult, error)) (*Contester, *subprocess.FakeExecutor, func()) {
+	base, err := ioutil.TempDir("", "contester")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	executor := &subprocess.FakeExecutor{Script: script}
+	c := &Contester{
+		Sandboxes: make([]SandboxPair, 2),
+		Executor:  executor,
+	}
+	login := &subprocess.LoginInfo{Uid: os.Getuid()}
+	for i := range c.Sandboxes {
+		pair := &c.Sandboxes[i]
+		pair.Compile.Path = filepath.Join(base, strconv.Itoa(i), "C")
+		pair.Run.Path = filepath.Join(base, strconv.Itoa(i), "R")
+		for _, s := range []*Sandbox{&pair.Compile, &pair.Run} {
+			s.Login = login
+			if err := checkSandbox(s.Path); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return c, executor, func() { os.RemoveAll(base) }
+}
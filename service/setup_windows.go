@@ -3,6 +3,8 @@ package service
 import (
 	"os/exec"
 	"strings"
+
+	log "github.com/Sirupsen/logrus"
 )
 
 const PLATFORM_ID = "win32"
@@ -10,9 +12,14 @@ const PLATFORM_ID = "win32"
 var PLATFORM_DISKS = []string{"C:\\"}
 var PLATFORM_PFILES = []string{"C:\\Program Files", "C:\\Program Files (x86)"}
 
-func setAcl(path, username string) error {
-	cmd := exec.Command("subinacl.exe", "/file", path, "/grant="+username+"=RWC")
-	cmd.Run()
+// Only the user is used, group and mode don't apply. Failures are logged, as subinacl
+// isn't available everywhere.
+func setAcl(s *Sandbox) error {
+	path := s.ownPath()
+	cmd := exec.Command("subinacl.exe", "/file", path, "/grant="+s.Owner.User+"=RWC")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		log.Errorf("subinacl %s: %s: %s", path, err, out)
+	}
 	return nil
 }
 
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/juju/errors"
+	"github.com/taskcluster/runlib/contester_proto"
+	"github.com/taskcluster/runlib/tools"
+)
+
+// Caps for recursive listing and ** matching.
+const (
+	MAX_STAT_DEPTH   = 32
+	MAX_STAT_ENTRIES = 10000
+)
+
+var errListingFull = errors.New("listing is full")
+
+// Collects FileStats, keeping track of the limits. Symlinks are reported, not followed.
+type lister struct {
+	checksum   string
+	recursive  bool
+	maxDepth   int
+	maxEntries int
+	seen       map[string]bool
+	result     *contester_proto.FileStats
+}
+
+func newLister(request *contester_proto.StatRequest, response *contester_proto.FileStats) (*lister, error) {
+	l := &lister{
+		recursive:  request.GetRecursive(),
+		maxDepth:   MAX_STAT_DEPTH,
+		maxEntries: MAX_STAT_ENTRIES,
+		seen:       make(map[string]bool),
+		result:     response,
+	}
+	if request.GetCalculateChecksum() {
+		l.checksum = contester_proto.CHECKSUM_SHA1
+		if request.ChecksumAlgorithm != nil {
+			l.checksum = request.GetChecksumAlgorithm()
+		}
+		if _, err := contester_proto.NewHash(l.checksum); err != nil {
+			return nil, err
+		}
+	}
+	if d := int(request.GetMaxDepth()); d > 0 && d < l.maxDepth {
+		l.maxDepth = d
+	}
+	if n := int(request.GetMaxEntries()); n > 0 && n < l.maxEntries {
+		l.maxEntries = n
+	}
+	return l, nil
+}
+
+func (l *lister) add(name string) (*contester_proto.FileStat, error) {
+	if l.seen[name] {
+		return nil, nil
+	}
+	if len(l.result.Entries) >= l.maxEntries {
+		l.result.Truncated = proto.Bool(true)
+		return nil, errListingFull
+	}
+	stat, err := tools.LstatFile(name, l.checksum)
+	if err != nil || stat == nil {
+		return nil, err
+	}
+	l.seen[name] = true
+	l.result.Entries = append(l.result.Entries, stat)
+	return stat, nil
+}
+
+// Walk the tree under root up to maxDepth levels, calling visit with the path
+// components relative to root. Symlinked directories are not entered.
+func (l *lister) walk(root string, visit func(path string, rel []string) error) error {
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if tools.IsStatErrorFileNotFound(err) {
+				return nil
+			}
+			return errors.Annotate(err, "filepath.Walk")
+		}
+		if path == root {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return errors.Annotate(err, "filepath.Rel")
+		}
+		parts := splitPath(rel)
+		if len(parts) > l.maxDepth {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		return visit(path, parts)
+	})
+	return err
+}
+
+// Add the path, and its contents if listing is recursive.
+func (l *lister) addTree(path string) error {
+	stat, err := l.add(path)
+	if err != nil || stat == nil || !l.recursive || !stat.GetIsDirectory() {
+		return err
+	}
+	return l.walk(path, func(path string, rel []string) error {
+		_, err := l.add(path)
+		return err
+	})
+}
+
+// Match the pattern with ** in it, by walking the tree under its longest static prefix.
+func (l *lister) globRecursive(pattern string) error {
+	root, rest := pattern, []string{}
+	for strings.ContainsAny(root, "*?[") {
+		rest = append([]string{filepath.Base(root)}, rest...)
+		root = filepath.Dir(root)
+	}
+	for _, part := range rest {
+		if _, err := filepath.Match(part, ""); err != nil {
+			return errors.BadRequestf("Bad pattern %s", pattern)
+		}
+	}
+	return l.walk(root, func(path string, rel []string) error {
+		if matchParts(rest, rel) {
+			return l.addTree(path)
+		}
+		return nil
+	})
+}
+
+// Match path components against pattern ones, ** matches any number of components.
+func matchParts(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchParts(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		if ok, _ := filepath.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
+}
+
+func (s *Contester) Stat(request *contester_proto.StatRequest, response *contester_proto.FileStats) error {
+	var sandboxes []*Sandbox
+	if request.SandboxId != nil {
+		sandbox, err := getSandboxById(s.Sandboxes, *request.SandboxId)
+		if err != nil {
+			return err
+		}
+		sandboxes = append(sandboxes, sandbox)
+	}
+	names := make([]string, len(request.Name))
+	for i, name := range request.Name {
+		var sandbox *Sandbox
+		var err error
+		if names[i], sandbox, err = resolvePathNoFollow(s.Sandboxes, name, false); err != nil {
+			return err
+		}
+		sandboxes = append(sandboxes, sandbox)
+	}
+	unlock, err := s.rlockLeased(sandboxes, request.GetLease())
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	response.Entries = make([]*contester_proto.FileStat, 0, len(request.Name))
+	l, err := newLister(request, response)
+	if err != nil {
+		return err
+	}
+	for _, resolved := range names {
+		if request.GetExpand() && strings.Contains(resolved, "**") {
+			err = l.globRecursive(resolved)
+		} else {
+			err = s.statGlob(l, resolved, request.GetExpand())
+		}
+		if err == errListingFull {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Contester) statGlob(l *lister, resolved string, expand bool) error {
+	expanded := []string{resolved}
+	if expand {
+		var err error
+		if expanded, err = globResolved(s.Sandboxes, resolved, false); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range expanded {
+		if err := l.addTree(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
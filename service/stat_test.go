@@ -0,0 +1,90 @@
+// +build linux
+
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/taskcluster/runlib/contester_proto"
+)
+
+func statNames(root string, stats *contester_proto.FileStats) []string {
+	var result []string
+	for _, entry := range stats.Entries {
+		rel, _ := filepath.Rel(root, entry.GetName())
+		result = append(result, rel)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func TestStatRecursive(t *testing.T) {
+	c, _, cleanup := newTestContester(t, nil)
+	defer cleanup()
+
+	root := c.Sandboxes[0].Compile.Path
+	if err := os.MkdirAll(filepath.Join(root, "a", "b"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"main.o", "a/x.o", "a/b/y.o", "a/b/y.c"} {
+		if err := ioutil.WriteFile(filepath.Join(root, name), []byte(name), 0640); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Symlink("/etc", filepath.Join(root, "a", "etc")); err != nil {
+		t.Fatal(err)
+	}
+
+	var stats contester_proto.FileStats
+	err := c.Stat(&contester_proto.StatRequest{
+		Name:   []string{"%0.C/**/*.o"},
+		Expand: proto.Bool(true),
+	}, &stats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := statNames(root, &stats); len(names) != 3 || names[0] != "a/b/y.o" || names[1] != "a/x.o" || names[2] != "main.o" {
+		t.Errorf("Unexpected ** matches %v", names)
+	}
+	for _, entry := range stats.Entries {
+		if entry.GetMode() != 0640 || entry.GetMtimeMicros() == 0 || entry.Uid == nil {
+			t.Errorf("Incomplete stat %v", entry)
+		}
+	}
+
+	stats.Reset()
+	err = c.Stat(&contester_proto.StatRequest{
+		Name:      []string{"%0.C/a"},
+		Recursive: proto.Bool(true),
+		MaxDepth:  proto.Uint32(1),
+	}, &stats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := statNames(root, &stats); len(names) != 4 || names[0] != "a" || names[1] != "a/b" || names[2] != "a/etc" || names[3] != "a/x.o" {
+		t.Errorf("Unexpected recursive listing %v", names)
+	}
+	for _, entry := range stats.Entries {
+		if filepath.Base(entry.GetName()) == "etc" && (entry.GetSymlinkTarget() != "/etc" || entry.GetIsDirectory()) {
+			t.Errorf("Symlink reported as %v", entry)
+		}
+	}
+
+	stats.Reset()
+	err = c.Stat(&contester_proto.StatRequest{
+		Name:       []string{"%0.C"},
+		Recursive:  proto.Bool(true),
+		MaxEntries: proto.Uint32(3),
+	}, &stats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats.Entries) != 3 || !stats.GetTruncated() {
+		t.Errorf("Expected truncated listing, got %v", &stats)
+	}
+}
@@ -3,7 +3,6 @@ package storage
 import (
 	"bytes"
 	"encoding/base64"
-	"encoding/hex"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -36,8 +35,31 @@ type uploadStatus struct {
 	Digests map[string]string
 }
 
+// Names of the checksum algorithms in the Digest header, RFC 3230 and RFC 5843.
+var digestNames = map[string]string{
+	contester_proto.CHECKSUM_SHA1:   "SHA",
+	contester_proto.CHECKSUM_SHA256: "SHA-256",
+}
+
+// Algorithm of the expected checksum, sha1 if there's none, and the checksum in
+// canonical form.
+func parseExpected(checksum string) (string, string, error) {
+	if checksum == "" {
+		return contester_proto.CHECKSUM_SHA1, "", nil
+	}
+	algo, sum, err := contester_proto.ParseChecksum(checksum)
+	if err != nil {
+		return "", "", err
+	}
+	return algo, contester_proto.FormatChecksum(algo, sum), nil
+}
+
 func filerUpload(localName, remoteName, checksum, moduleType, authToken string) (stat *contester_proto.FileStat, err error) {
-	if stat, err = tools.StatFile(localName, true); err != nil || stat == nil {
+	algo, checksum, err := parseExpected(checksum)
+	if err != nil {
+		return nil, err
+	}
+	if stat, err = tools.StatFile(localName, algo); err != nil || stat == nil {
 		return stat, err
 	}
 	if checksum != "" && stat.GetChecksum() != checksum {
@@ -62,11 +84,12 @@ func filerUpload(localName, remoteName, checksum, moduleType, authToken string)
 		req.Header.Add("Authorization", "bearer "+authToken)
 	}
 	req.Header.Add("X-FS-Content-Length", strconv.FormatUint(stat.GetSize_(), 10))
-	var base64sha1 string
-	if checksum != "" && strings.HasPrefix(checksum, "sha1:") {
-		if data, err := hex.DecodeString(strings.TrimPrefix(checksum, "sha1:")); err == nil {
-			base64sha1 = base64.StdEncoding.EncodeToString(data)
-			req.Header.Add("Digest", "SHA="+base64sha1)
+	digestName, hasDigest := digestNames[algo]
+	var digest string
+	if hasDigest {
+		if _, sum, err := contester_proto.ParseChecksum(checksum); err == nil {
+			digest = base64.StdEncoding.EncodeToString(sum)
+			req.Header.Add("Digest", digestName+"="+digest)
 		}
 	}
 	resp, err := http.DefaultClient.Do(req)
@@ -79,14 +102,18 @@ func filerUpload(localName, remoteName, checksum, moduleType, authToken string)
 	if err != nil {
 		return nil, errors.Annotate(err, "json.Decode")
 	}
-	if st.Size != int64(stat.GetSize_()) || (base64sha1 != "" && base64sha1 != st.Digests["SHA"]) {
+	if st.Size != int64(stat.GetSize_()) || (digest != "" && digest != st.Digests[digestName]) {
 		return nil, errors.NotValidf("upload integrity verification failed")
 	}
 	return stat, nil
 }
 
-// remoteName must be full URL.
-func filerDownload(localName, remoteName, authToken string) (stat *contester_proto.FileStat, err error) {
+// remoteName must be full URL. The file is verified against the checksum, if it's given.
+func filerDownload(localName, remoteName, checksum, authToken string) (stat *contester_proto.FileStat, err error) {
+	algo, checksum, err := parseExpected(checksum)
+	if err != nil {
+		return nil, err
+	}
 	local, err := os.Create(localName)
 	if err != nil {
 		return nil, err
@@ -108,14 +135,21 @@ func filerDownload(localName, remoteName, authToken string) (stat *contester_pro
 		return nil, err
 	}
 	local.Close()
-	return tools.StatFile(localName, true)
+	if stat, err = tools.StatFile(localName, algo); err != nil || stat == nil {
+		return stat, err
+	}
+	if checksum != "" && stat.GetChecksum() != checksum {
+		os.Remove(localName)
+		return nil, errors.NotValidf("Checksum mismatch, downloaded %q != %q", stat.GetChecksum(), checksum)
+	}
+	return stat, nil
 }
 
 func filerCopy(localName, remoteName string, toRemote bool, checksum, moduleType, authToken string) (stat *contester_proto.FileStat, err error) {
 	if toRemote {
 		return filerUpload(localName, remoteName, checksum, moduleType, authToken)
 	}
-	return filerDownload(localName, remoteName, authToken)
+	return filerDownload(localName, remoteName, checksum, authToken)
 }
 
 func isFilerRemote(src string) string {
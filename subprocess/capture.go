@@ -0,0 +1,61 @@
+package subprocess
+
+import "bytes"
+
+// Captured output of the process. Truncated is set if some of the output
+// didn't make it into the buffer.
+type outputBuffer struct {
+	bytes.Buffer
+	Truncated bool
+}
+
+// Keeps first head and last tail bytes of the stream in the output buffer.
+type headTailWriter struct {
+	out        *outputBuffer
+	head, tail int
+	last       []byte
+	skipped    bool
+}
+
+func newHeadTailWriter(out *outputBuffer, head, tail int) *headTailWriter {
+	if head > MAX_MEM_OUTPUT {
+		head = MAX_MEM_OUTPUT
+	}
+	if tail > MAX_MEM_OUTPUT {
+		tail = MAX_MEM_OUTPUT
+	}
+	return &headTailWriter{out: out, head: head, tail: tail}
+}
+
+func (w *headTailWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	if room := w.head - w.out.Len(); room > 0 {
+		if room > len(p) {
+			room = len(p)
+		}
+		w.out.Write(p[:room])
+		p = p[room:]
+	}
+	if len(p) == 0 {
+		return n, nil
+	}
+
+	w.last = append(w.last, p...)
+	// Trim lazily, so that copying is amortized over at least tail bytes.
+	if len(w.last) > 2*w.tail {
+		w.skipped = true
+		w.last = append(w.last[:0], w.last[len(w.last)-w.tail:]...)
+	}
+	return n, nil
+}
+
+// Append the tail to the buffer. Must be called once the stream is finished.
+func (w *headTailWriter) finish() {
+	if len(w.last) > w.tail {
+		w.skipped = true
+		w.last = w.last[len(w.last)-w.tail:]
+	}
+	w.out.Write(w.last)
+	w.out.Truncated = w.skipped
+	w.last = nil
+}
@@ -0,0 +1,48 @@
+// +build linux
+
+package subprocess
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHeadTailWriter(t *testing.T) {
+	for _, c := range []struct {
+		writes     []string
+		head, tail int
+		expected   string
+		truncated  bool
+	}{
+		{[]string{"abcdef"}, 10, 10, "abcdef", false},
+		{[]string{"abcdef"}, 6, 0, "abcdef", false},
+		{[]string{"abcdef"}, 3, 0, "abc", true},
+		{[]string{"abcdef"}, 0, 3, "def", true},
+		{[]string{"abcdef"}, 2, 2, "abef", true},
+		{[]string{"abcdef"}, 3, 3, "abcdef", false},
+		{[]string{"ab", "cd", "ef", "gh"}, 1, 3, "afgh", true},
+		{[]string{"a", "b", "c", "d", "e", "f", "g"}, 0, 2, "fg", true},
+		{[]string{strings.Repeat("x", 100), "yz"}, 2, 2, "xxyz", true},
+		{nil, 5, 5, "", false},
+	} {
+		var out outputBuffer
+		w := newHeadTailWriter(&out, c.head, c.tail)
+		for _, s := range c.writes {
+			if n, err := w.Write([]byte(s)); n != len(s) || err != nil {
+				t.Fatalf("Write(%q) = %d, %v", s, n, err)
+			}
+		}
+		w.finish()
+		if out.String() != c.expected || out.Truncated != c.truncated {
+			t.Errorf("%q with head %d, tail %d: got %q (truncated %v), expected %q (truncated %v)",
+				c.writes, c.head, c.tail, out.String(), out.Truncated, c.expected, c.truncated)
+		}
+	}
+}
+
+func TestHeadTailWriterLimit(t *testing.T) {
+	w := newHeadTailWriter(&outputBuffer{}, MAX_MEM_OUTPUT+1, MAX_MEM_OUTPUT*2)
+	if w.head != MAX_MEM_OUTPUT || w.tail != MAX_MEM_OUTPUT {
+		t.Errorf("head %d, tail %d are not capped at %d", w.head, w.tail, MAX_MEM_OUTPUT)
+	}
+}
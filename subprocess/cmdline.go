@@ -0,0 +1,68 @@
+package subprocess
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// Split command line into arguments, following POSIX shell quoting rules: single quotes
+// are literal, double quotes allow \-escaping of $ ` " \ and newline, unquoted backslash
+// escapes any character. No expansions are done.
+func SplitCommandLine(s string) ([]string, error) {
+	var result []string
+	var current bytes.Buffer
+	inWord := false
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			if inWord {
+				result = append(result, current.String())
+				current.Reset()
+				inWord = false
+			}
+		case c == '\\':
+			if i+1 == len(s) {
+				return nil, errors.BadRequestf("trailing backslash in %q", s)
+			}
+			i++
+			if s[i] != '\n' {
+				inWord = true
+				current.WriteByte(s[i])
+			}
+		case c == '\'':
+			inWord = true
+			end := strings.IndexByte(s[i+1:], '\'')
+			if end < 0 {
+				return nil, errors.BadRequestf("unterminated single quote in %q", s)
+			}
+			current.WriteString(s[i+1 : i+1+end])
+			i += end + 1
+		case c == '"':
+			inWord = true
+			i++
+			for ; i < len(s) && s[i] != '"'; i++ {
+				if s[i] == '\\' && i+1 < len(s) && strings.IndexByte("$`\"\\\n", s[i+1]) >= 0 {
+					i++
+					if s[i] == '\n' {
+						continue
+					}
+				}
+				current.WriteByte(s[i])
+			}
+			if i == len(s) {
+				return nil, errors.BadRequestf("unterminated double quote in %q", s)
+			}
+		default:
+			inWord = true
+			current.WriteByte(c)
+		}
+	}
+	if inWord {
+		result = append(result, current.String())
+	}
+	return result, nil
+}
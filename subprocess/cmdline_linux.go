@@ -0,0 +1,145 @@
+package subprocess
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/juju/errors"
+)
+
+const (
+	// Kernel only looks at this many bytes of the #! line.
+	MAX_SHEBANG = 256
+	// Nested interpreters allowed by the kernel.
+	MAX_INTERPRETER_DEPTH = 4
+	// Used for lookup if the child environment has no PATH, same as the shell does.
+	DEFAULT_PATH = "/usr/local/bin:/usr/bin:/bin"
+)
+
+// Work out the file to exec and its argv. Parameters take precedence over the command line,
+// argv[0] is used if there's no application name.
+func (c *CommandLine) resolve(env *[]string, cwd *string) (string, []string, error) {
+	argv := c.Parameters
+	if len(argv) == 0 && c.CommandLine != nil {
+		var err error
+		if argv, err = SplitCommandLine(*c.CommandLine); err != nil {
+			return "", nil, err
+		}
+	}
+
+	var filename string
+	switch {
+	case c.ApplicationName != nil:
+		filename = *c.ApplicationName
+	case len(argv) > 0:
+		filename = argv[0]
+	default:
+		return "", nil, errors.BadRequestf("Application name must be present")
+	}
+
+	if c.SearchPath && !strings.Contains(filename, "/") {
+		path, ok := getenv(env, "PATH")
+		if !ok {
+			path = DEFAULT_PATH
+		}
+		var err error
+		if filename, err = lookPath(filename, path, cwd); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if err := checkInterpreter(filename, cwd, 0); err != nil {
+		return "", nil, err
+	}
+	return filename, argv, nil
+}
+
+func getenv(env *[]string, key string) (string, bool) {
+	if env == nil {
+		return "", false
+	}
+	for _, v := range *env {
+		if strings.HasPrefix(v, key+"=") {
+			return v[len(key)+1:], true
+		}
+	}
+	return "", false
+}
+
+// Path as seen by the parent; child resolves relative paths after chdir.
+func inChildDir(name string, cwd *string) string {
+	if cwd == nil || filepath.IsAbs(name) {
+		return name
+	}
+	return filepath.Join(*cwd, name)
+}
+
+func statErrno(err error) syscall.Errno {
+	if pe, ok := err.(*os.PathError); ok {
+		if errno, ok := pe.Err.(syscall.Errno); ok {
+			return errno
+		}
+	}
+	return syscall.EINVAL
+}
+
+func isExecutable(fi os.FileInfo) bool {
+	return fi.Mode().IsRegular() && fi.Mode()&0111 != 0
+}
+
+func lookPath(name, path string, cwd *string) (string, error) {
+	for _, dir := range filepath.SplitList(path) {
+		if dir == "" {
+			dir = "."
+		}
+		candidate := filepath.Join(dir, name)
+		if fi, err := os.Stat(inChildDir(candidate, cwd)); err == nil && isExecutable(fi) {
+			if !strings.Contains(candidate, "/") {
+				candidate = "./" + candidate
+			}
+			return candidate, nil
+		}
+	}
+	return "", &SetupError{Stage: "lookup", Errno: syscall.ENOENT, Path: name}
+}
+
+// Check that the #! interpreter of the script, if any, exists and is executable. Exec
+// would fail anyway, but with ENOENT for the script itself.
+func checkInterpreter(filename string, cwd *string, depth int) error {
+	f, err := os.Open(inChildDir(filename, cwd))
+	if err != nil {
+		// Let exec report it.
+		return nil
+	}
+	head := make([]byte, MAX_SHEBANG)
+	n, _ := f.Read(head)
+	f.Close()
+	head = head[:n]
+
+	if !bytes.HasPrefix(head, []byte("#!")) {
+		return nil
+	}
+	if depth == MAX_INTERPRETER_DEPTH {
+		return &SetupError{Stage: "interpreter", Errno: syscall.ELOOP, Path: filename}
+	}
+	if i := bytes.IndexByte(head, '\n'); i >= 0 {
+		head = head[:i]
+	}
+	fields := strings.Fields(string(head[2:]))
+	if len(fields) == 0 {
+		return &SetupError{Stage: "interpreter", Errno: syscall.ENOEXEC, Path: filename}
+	}
+	interpreter := fields[0]
+
+	fi, err := os.Stat(inChildDir(interpreter, cwd))
+	if err != nil {
+		return &SetupError{Stage: "interpreter", Errno: statErrno(err), Path: interpreter}
+	}
+	if !isExecutable(fi) {
+		return &SetupError{Stage: "interpreter", Errno: syscall.EACCES, Path: interpreter}
+	}
+	return checkInterpreter(interpreter, cwd, depth+1)
+}
@@ -0,0 +1,49 @@
+// +build linux
+
+package subprocess
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitCommandLine(t *testing.T) {
+	for _, c := range []struct {
+		line     string
+		expected []string
+	}{
+		{"", nil},
+		{"   ", nil},
+		{"a", []string{"a"}},
+		{"a b\tc\nd", []string{"a", "b", "c", "d"}},
+		{"  a   b  ", []string{"a", "b"}},
+		{`'a b' c`, []string{"a b", "c"}},
+		{`'a\b'`, []string{`a\b`}},
+		{`''`, []string{""}},
+		{`""`, []string{""}},
+		{`"a b" "c\"d"`, []string{"a b", `c"d`}},
+		{`"\$x \a \\"`, []string{`$x \a \`}},
+		{"\"a\\\nb\"", []string{"ab"}},
+		{`a\ b`, []string{"a b"}},
+		{"a\\\nb", []string{"ab"}},
+		{`a'b'"c"d`, []string{"abcd"}},
+		{`--opt="x y"`, []string{"--opt=x y"}},
+	} {
+		result, err := SplitCommandLine(c.line)
+		if err != nil {
+			t.Errorf("SplitCommandLine(%q): %s", c.line, err)
+			continue
+		}
+		if !reflect.DeepEqual(result, c.expected) {
+			t.Errorf("SplitCommandLine(%q) = %q, expected %q", c.line, result, c.expected)
+		}
+	}
+}
+
+func TestSplitCommandLineErrors(t *testing.T) {
+	for _, line := range []string{`a\`, `'abc`, `"abc`, `"abc\"`, `a 'b" c`} {
+		if result, err := SplitCommandLine(line); err == nil {
+			t.Errorf("SplitCommandLine(%q) = %q, expected error", line, result)
+		}
+	}
+}
@@ -7,7 +7,36 @@ import (
 	"github.com/juju/errors"
 )
 
+// Failure to start the child process, after the process object was created but before
+// the program got control.
+type SetupError struct {
+	Stage string // e.g. "exec", "chdir", "CreateProcess"
+	Errno syscall.Errno
+	Path  string // file or directory the stage operated on, if any
+}
+
+func (e *SetupError) Error() string {
+	if e.Path != "" {
+		return e.Stage + " " + e.Path + ": " + e.Errno.Error()
+	}
+	return e.Stage + ": " + e.Errno.Error()
+}
+
+// True if the failure is caused by the request (missing or non-executable binary,
+// bad working directory), rather than by the invoker.
+func (e *SetupError) UserError() bool {
+	for _, errno := range userSetupErrnos[e.Stage] {
+		if e.Errno == errno {
+			return true
+		}
+	}
+	return false
+}
+
 func IsUserError(err error) bool {
+	if se, ok := errors.Cause(err).(*SetupError); ok {
+		return se.UserError()
+	}
 	return errors.IsBadRequest(err)
 }
 
@@ -0,0 +1,32 @@
+package subprocess
+
+import (
+	"syscall"
+
+	"github.com/taskcluster/runlib/linux"
+)
+
+var userSetupErrnos = map[string][]syscall.Errno{
+	"exec": {syscall.ENOENT, syscall.EACCES, syscall.ENOEXEC, syscall.ENOTDIR, syscall.ELOOP,
+		syscall.ENAMETOOLONG, syscall.EISDIR, syscall.ETXTBSY, syscall.E2BIG},
+	"chdir":  {syscall.ENOENT, syscall.EACCES, syscall.ENOTDIR, syscall.ELOOP, syscall.ENAMETOOLONG},
+	"lookup": {syscall.ENOENT},
+	"interpreter": {syscall.ENOENT, syscall.EACCES, syscall.ENOEXEC, syscall.ENOTDIR, syscall.ELOOP,
+		syscall.ENAMETOOLONG},
+}
+
+func newSetupError(sub *Subprocess, filename string, ce *linux.ChildError) *SetupError {
+	result := &SetupError{
+		Stage: ce.StageName(),
+		Errno: ce.Errno,
+	}
+	switch ce.Stage {
+	case linux.STAGE_EXEC:
+		result.Path = filename
+	case linux.STAGE_CHDIR:
+		if sub.CurrentDirectory != nil {
+			result.Path = *sub.CurrentDirectory
+		}
+	}
+	return result
+}
@@ -0,0 +1,13 @@
+package subprocess
+
+import "syscall"
+
+const (
+	_ERROR_BAD_EXE_FORMAT = 193
+	_ERROR_DIRECTORY      = 267
+)
+
+var userSetupErrnos = map[string][]syscall.Errno{
+	"CreateProcess": {syscall.ERROR_FILE_NOT_FOUND, syscall.ERROR_PATH_NOT_FOUND, syscall.ERROR_ACCESS_DENIED,
+		_ERROR_BAD_EXE_FORMAT, _ERROR_DIRECTORY},
+}
@@ -0,0 +1,50 @@
+package subprocess
+
+import "sync"
+
+// Executor runs subprocesses. Tests substitute FakeExecutor, which doesn't need
+// root, cgroups or the clone helper.
+type Executor interface {
+	Execute(sub *Subprocess) (*SubprocessResult, error)
+}
+
+// Runs processes for real.
+type LocalExecutor struct{}
+
+func (LocalExecutor) Execute(sub *Subprocess) (*SubprocessResult, error) {
+	return sub.Execute()
+}
+
+// Doesn't run anything, returns whatever Script says. Pipe redirects are closed,
+// as they would be after the process exit.
+type FakeExecutor struct {
+	// If nil, every execution succeeds with zero result.
+	Script func(sub *Subprocess) (*SubprocessResult, error)
+
+	mu       sync.Mutex
+	executed []*Subprocess
+}
+
+func (f *FakeExecutor) Execute(sub *Subprocess) (*SubprocessResult, error) {
+	f.mu.Lock()
+	f.executed = append(f.executed, sub)
+	f.mu.Unlock()
+
+	for _, r := range []*Redirect{sub.StdIn, sub.StdOut, sub.StdErr} {
+		if r != nil && r.Mode == REDIRECT_PIPE && r.Pipe != nil {
+			r.Pipe.Close()
+		}
+	}
+
+	if f.Script == nil {
+		return &SubprocessResult{}, nil
+	}
+	return f.Script(sub)
+}
+
+// All subprocesses executed so far, in order.
+func (f *FakeExecutor) Executed() []*Subprocess {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]*Subprocess(nil), f.executed...)
+}
@@ -0,0 +1,55 @@
+// +build linux
+
+package subprocess
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Read what the program would see on the slave side until EOF.
+func readUntilEOF(t *testing.T, input string) string {
+	master, slave, err := openPty()
+	if err != nil {
+		t.Skip("No pseudo-terminals: ", err)
+	}
+	defer master.Close()
+	defer slave.Close()
+
+	typeInput(master, strings.NewReader(input))
+	done := make(chan string, 1)
+	go func() {
+		var result []byte
+		buf := make([]byte, 1024)
+		for {
+			n, err := slave.Read(buf)
+			result = append(result, buf[:n]...)
+			if err == io.EOF {
+				done <- string(result)
+				return
+			}
+			if err != nil {
+				done <- "error: " + err.Error()
+				return
+			}
+		}
+	}()
+	select {
+	case result := <-done:
+		return result
+	case <-time.After(5 * time.Second):
+		slave.Close()
+		t.Fatalf("No EOF after input %q", input)
+	}
+	return ""
+}
+
+func TestTypeInput(t *testing.T) {
+	for _, input := range []string{"abc\n", "abc", "a\nbc", ""} {
+		if result := readUntilEOF(t, input); result != input {
+			t.Errorf("Typed %q, read %q", input, result)
+		}
+	}
+}
@@ -3,7 +3,10 @@ package subprocess
 import (
 	"bytes"
 	"io"
+	"io/ioutil"
 	"os"
+	"sync"
+	"syscall"
 
 	"github.com/juju/errors"
 )
@@ -13,17 +16,36 @@ type Redirect struct {
 	Filename *string
 	Pipe     *os.File
 	Data     []byte
+	Reader   io.Reader // closed after use if it is also an io.Closer
+
+	// For REDIRECT_TEE, how many bytes to keep from the start and the end of the output.
+	Head, Tail int
 }
 
 const MAX_MEM_OUTPUT = 1024 * 1024
 
-func (d *SubprocessData) SetupOutputMemory(b *bytes.Buffer) (*os.File, error) {
+// Close the reader or the pipe of the redirect that will not be used. Other ends of
+// the pipe would otherwise never see EOF.
+func (r *Redirect) release() {
+	if r == nil {
+		return
+	}
+	if c, ok := r.Reader.(io.Closer); ok {
+		c.Close()
+	}
+	if r.Mode == REDIRECT_PIPE && r.Pipe != nil {
+		r.Pipe.Close()
+	}
+}
+
+func (d *SubprocessData) SetupOutputMemory(b *outputBuffer) (*os.File, error) {
 	reader, writer, e := os.Pipe()
 	if e != nil {
 		return nil, errors.Trace(e)
 	}
 
 	d.closeAfterStart = append(d.closeAfterStart, writer)
+	d.closeOnFailure = append(d.closeOnFailure, reader)
 
 	d.startAfterStart = append(d.startAfterStart, func() error {
 		_, err := io.Copy(b, io.LimitReader(reader, MAX_MEM_OUTPUT))
@@ -33,6 +55,35 @@ func (d *SubprocessData) SetupOutputMemory(b *bytes.Buffer) (*os.File, error) {
 	return writer, nil
 }
 
+// Write the whole output to the file, keeping head and tail of it in b.
+func (d *SubprocessData) SetupOutputTee(filename string, b *outputBuffer, head, tail int) (*os.File, error) {
+	file, e := OpenFileForRedirect(filename, false)
+	if e != nil {
+		return nil, e
+	}
+
+	reader, writer, e := os.Pipe()
+	if e != nil {
+		file.Close()
+		return nil, errors.Trace(e)
+	}
+
+	d.closeAfterStart = append(d.closeAfterStart, writer)
+	d.closeOnFailure = append(d.closeOnFailure, reader, file)
+
+	d.startAfterStart = append(d.startAfterStart, func() error {
+		capture := newHeadTailWriter(b, head, tail)
+		_, err := io.Copy(io.MultiWriter(file, capture), reader)
+		capture.finish()
+		reader.Close()
+		if err1 := file.Close(); err == nil {
+			err = err1
+		}
+		return errors.Annotate(err, filename)
+	})
+	return writer, nil
+}
+
 func (d *SubprocessData) SetupFile(filename string, read bool) (*os.File, error) {
 	writer, e := OpenFileForRedirect(filename, read)
 	if e != nil {
@@ -48,7 +99,125 @@ func (d *SubprocessData) SetupPipe(f *os.File) (*os.File, error) {
 	return f, nil
 }
 
-func (d *SubprocessData) SetupOutput(w *Redirect, b *bytes.Buffer) (*os.File, error) {
+// Pseudo-terminal shared by all descriptors redirected to REDIRECT_PTY.
+type ptyState struct {
+	master, slave *os.File
+	output        io.Writer
+	finish        func() error
+	hasOutput     bool
+}
+
+// Reads from the master fail with EIO once all slave descriptors are closed.
+func isPtyEOF(err error) bool {
+	if pe, ok := err.(*os.PathError); ok {
+		return pe.Err == syscall.EIO
+	}
+	return false
+}
+
+// Default end of file character, ^D.
+const VEOF = 4
+
+// Remembers the last byte written through it.
+type lastByteWriter struct {
+	w    io.Writer
+	last byte
+}
+
+func (l *lastByteWriter) Write(p []byte) (int, error) {
+	n, err := l.w.Write(p)
+	if n > 0 {
+		l.last = p[n-1]
+	}
+	return n, err
+}
+
+// Type the input into the terminal, followed by EOF. Write errors are expected if the
+// program exits without reading everything, and master is closed then.
+func typeInput(master io.Writer, r io.Reader) {
+	last := &lastByteWriter{w: master, last: '\n'}
+	if _, err := io.Copy(last, r); err != nil {
+		return
+	}
+	// In canonical mode VEOF after a partial line only passes the line to the program,
+	// the second one is read as the end of input.
+	eof := []byte{VEOF}
+	if last.last != '\n' {
+		eof = append(eof, VEOF)
+	}
+	master.Write(eof)
+}
+
+// Returns the slave side of the process pty, allocating it on first use. For output,
+// everything the terminal produces (including echo) goes to the file, if Filename is set,
+// or to memory. For input, Data or Reader is typed into the terminal, followed by EOF.
+func (d *SubprocessData) SetupPty(w *Redirect, b *outputBuffer, input bool) (*os.File, error) {
+	if d.pty == nil {
+		master, slave, err := openPty()
+		if err != nil {
+			return nil, err
+		}
+		pty := &ptyState{master: master, slave: slave, output: ioutil.Discard}
+		d.pty = pty
+		d.closeAfterStart = append(d.closeAfterStart, slave)
+		d.closeOnFailure = append(d.closeOnFailure, master)
+		// Terminal output must be drained even if nobody wants it, or the child will block.
+		d.startAfterStart = append(d.startAfterStart, func() error {
+			_, err := io.Copy(pty.output, pty.master)
+			pty.master.Close()
+			if isPtyEOF(err) {
+				err = nil
+			}
+			if pty.finish != nil {
+				if err1 := pty.finish(); err == nil {
+					err = err1
+				}
+			}
+			return errors.Annotate(err, "pty")
+		})
+	}
+
+	if input {
+		if w.Data != nil || w.Reader != nil {
+			master := d.pty.master
+			d.startAfterStart = append(d.startAfterStart, func() error {
+				var r io.Reader = bytes.NewReader(w.Data)
+				if w.Reader != nil {
+					r = w.Reader
+				}
+				typeInput(master, r)
+				if c, ok := r.(io.Closer); ok {
+					c.Close()
+				}
+				return nil
+			})
+		}
+		return d.pty.slave, nil
+	}
+
+	if d.pty.hasOutput {
+		return d.pty.slave, nil
+	}
+	d.pty.hasOutput = true
+	if w.Filename != nil {
+		file, err := OpenFileForRedirect(*w.Filename, false)
+		if err != nil {
+			return nil, err
+		}
+		d.pty.output, d.pty.finish = file, file.Close
+		d.closeOnFailure = append(d.closeOnFailure, file)
+	} else {
+		capture := newHeadTailWriter(b, MAX_MEM_OUTPUT, 0)
+		d.pty.output = capture
+		d.pty.finish = func() error {
+			capture.finish()
+			return nil
+		}
+	}
+	return d.pty.slave, nil
+}
+
+func (d *SubprocessData) SetupOutput(w *Redirect, b *outputBuffer) (*os.File, error) {
 	if w == nil {
 		return WriterDefault()
 	}
@@ -58,6 +227,10 @@ func (d *SubprocessData) SetupOutput(w *Redirect, b *bytes.Buffer) (*os.File, er
 		return d.SetupOutputMemory(b)
 	case REDIRECT_FILE:
 		return d.SetupFile(*w.Filename, false)
+	case REDIRECT_TEE:
+		return d.SetupOutputTee(*w.Filename, b, w.Head, w.Tail)
+	case REDIRECT_PTY:
+		return d.SetupPty(w, b, false)
 	case REDIRECT_PIPE:
 		return d.SetupPipe(w.Pipe)
 	}
@@ -70,6 +243,7 @@ func (d *SubprocessData) SetupInputMemory(b []byte) (*os.File, error) {
 		return nil, errors.Annotate(e, "os.Pipe")
 	}
 	d.closeAfterStart = append(d.closeAfterStart, reader)
+	d.closeOnFailure = append(d.closeOnFailure, writer)
 	d.startAfterStart = append(d.startAfterStart, func() error {
 		_, err := io.Copy(writer, bytes.NewBuffer(b))
 		if err1 := writer.Close(); err == nil {
@@ -80,6 +254,30 @@ func (d *SubprocessData) SetupInputMemory(b []byte) (*os.File, error) {
 	return reader, nil
 }
 
+// Pump the reader into the child's stdin. Pipe provides back-pressure, so only one chunk
+// is held in memory at a time.
+func (d *SubprocessData) SetupInputStream(r io.Reader) (*os.File, error) {
+	reader, writer, e := os.Pipe()
+	if e != nil {
+		return nil, errors.Annotate(e, "os.Pipe")
+	}
+	d.closeAfterStart = append(d.closeAfterStart, reader)
+	d.closeOnFailure = append(d.closeOnFailure, writer)
+	d.startAfterStart = append(d.startAfterStart, func() error {
+		_, err := io.Copy(writer, r)
+		if err1 := writer.Close(); err == nil {
+			err = err1
+		}
+		if c, ok := r.(io.Closer); ok {
+			if err1 := c.Close(); err == nil {
+				err = err1
+			}
+		}
+		return errors.Annotate(err, "stdin stream")
+	})
+	return reader, nil
+}
+
 func (d *SubprocessData) SetupInput(w *Redirect) (*os.File, error) {
 	if w == nil {
 		return ReaderDefault()
@@ -88,6 +286,10 @@ func (d *SubprocessData) SetupInput(w *Redirect) (*os.File, error) {
 	switch w.Mode {
 	case REDIRECT_MEMORY:
 		return d.SetupInputMemory(w.Data)
+	case REDIRECT_STREAM:
+		return d.SetupInputStream(w.Reader)
+	case REDIRECT_PTY:
+		return d.SetupPty(w, nil, true)
 	case REDIRECT_PIPE:
 		return d.SetupPipe(w.Pipe)
 	case REDIRECT_FILE:
@@ -96,7 +298,8 @@ func (d *SubprocessData) SetupInput(w *Redirect) (*os.File, error) {
 	return ReaderDefault()
 }
 
-func recordingTee(w io.WriteCloser, r io.ReadCloser, t io.Writer) {
+func recordingTee(w io.WriteCloser, r io.ReadCloser, t io.Writer, wg *sync.WaitGroup) {
+	defer wg.Done()
 	m := io.MultiWriter(w, t)
 	io.Copy(m, r)
 	w.Close()
@@ -111,6 +314,15 @@ func RecordingPipe(d *os.File) (*os.File, *os.File, error) {
 	if d == nil {
 		return os.Pipe()
 	}
+	var wg sync.WaitGroup
+	return recordingPipe(d, &wg)
+}
+
+// Same as RecordingPipe, but takes any writer. Recorder goroutine is added to wg.
+func recordingPipe(d io.Writer, wg *sync.WaitGroup) (*os.File, *os.File, error) {
+	if d == nil {
+		return os.Pipe()
+	}
 
 	r1, w1, e := os.Pipe()
 	if e != nil {
@@ -119,42 +331,37 @@ func RecordingPipe(d *os.File) (*os.File, *os.File, error) {
 
 	r2, w2, e := os.Pipe()
 	if e != nil {
+		r1.Close()
+		w1.Close()
 		return nil, nil, errors.Trace(e)
 	}
 
-	go recordingTee(w1, r2, d)
+	wg.Add(1)
+	go recordingTee(w1, r2, d, wg)
 
 	return r1, w2, nil
 }
 
-func Interconnect(s1, s2 *Subprocess, d1, d2 *os.File) error {
-	read1, write1, err := RecordingPipe(d1)
-	if err != nil {
-		return err
-	}
-
-	read2, write2, err := RecordingPipe(d2)
-	if err != nil {
-		read1.Close()
-		write1.Close()
-		return err
+// Topology with stdin and stdout of two processes cross-connected. Input of s1 is
+// recorded to d1, output of s1 to d2; recorders must be nil interfaces if unused.
+func Interconnected(s1, s2 *Subprocess, d1, d2 io.Writer) *Topology {
+	return &Topology{
+		Processes: []*Subprocess{s1, s2},
+		Edges: []Edge{
+			{From: 1, FromFd: FD_STDOUT, To: 0, ToFd: FD_STDIN, Record: d1},
+			{From: 0, FromFd: FD_STDOUT, To: 1, ToFd: FD_STDIN, Record: d2},
+		},
 	}
+}
 
-	s1.StdIn = &Redirect{
-		Mode: REDIRECT_PIPE,
-		Pipe: read1,
-	}
-	s2.StdOut = &Redirect{
-		Mode: REDIRECT_PIPE,
-		Pipe: write1,
-	}
-	s1.StdOut = &Redirect{
-		Mode: REDIRECT_PIPE,
-		Pipe: write2,
+// Cross-connect stdin and stdout of two processes, see Interconnected.
+func Interconnect(s1, s2 *Subprocess, d1, d2 *os.File) error {
+	var r1, r2 io.Writer
+	if d1 != nil {
+		r1 = d1
 	}
-	s2.StdIn = &Redirect{
-		Mode: REDIRECT_PIPE,
-		Pipe: read2,
+	if d2 != nil {
+		r2 = d2
 	}
-	return nil
+	return Interconnected(s1, s2, r1, r2).Connect()
 }
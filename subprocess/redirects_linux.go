@@ -2,6 +2,11 @@ package subprocess
 
 import (
 	"os"
+	"strconv"
+	"syscall"
+	"unsafe"
+
+	"github.com/juju/errors"
 )
 
 func OpenFileForRedirect(name string, read bool) (*os.File, error) {
@@ -18,3 +23,40 @@ func ReaderDefault() (*os.File, error) {
 func WriterDefault() (*os.File, error) {
 	return os.Create("/dev/null")
 }
+
+func ioctl(fd int, req uintptr, arg unsafe.Pointer) error {
+	if _, _, e := syscall.Syscall(syscall.SYS_IOCTL, uintptr(fd), req, uintptr(arg)); e != 0 {
+		return e
+	}
+	return nil
+}
+
+// Allocate a pseudo-terminal pair. Master is non-blocking, so that closing it
+// interrupts pending reads and writes; slave is left blocking for the child.
+func openPty() (*os.File, *os.File, error) {
+	master, err := syscall.Open("/dev/ptmx", syscall.O_RDWR|syscall.O_NOCTTY|syscall.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, nil, errors.Trace(os.NewSyscallError("open /dev/ptmx", err))
+	}
+
+	var n uint32
+	if err = ioctl(master, syscall.TIOCGPTN, unsafe.Pointer(&n)); err != nil {
+		syscall.Close(master)
+		return nil, nil, errors.Trace(os.NewSyscallError("TIOCGPTN", err))
+	}
+	var unlock int32
+	if err = ioctl(master, syscall.TIOCSPTLCK, unsafe.Pointer(&unlock)); err != nil {
+		syscall.Close(master)
+		return nil, nil, errors.Trace(os.NewSyscallError("TIOCSPTLCK", err))
+	}
+
+	name := "/dev/pts/" + strconv.Itoa(int(n))
+	slave, err := syscall.Open(name, syscall.O_RDWR|syscall.O_NOCTTY|syscall.O_CLOEXEC, 0)
+	if err != nil {
+		syscall.Close(master)
+		return nil, nil, errors.Trace(os.NewSyscallError("open "+name, err))
+	}
+
+	syscall.SetNonblock(master, true)
+	return os.NewFile(uintptr(master), "/dev/ptmx"), os.NewFile(uintptr(slave), name), nil
+}
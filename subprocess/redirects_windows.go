@@ -41,3 +41,7 @@ func ReaderDefault() (*os.File, error) {
 func WriterDefault() (*os.File, error) {
 	return nil, nil
 }
+
+func openPty() (*os.File, *os.File, error) {
+	return nil, nil, errors.NotSupportedf("pseudo-terminals")
+}
@@ -0,0 +1,214 @@
+package subprocess
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/juju/errors"
+)
+
+// How the reported run is chosen out of repeated ones.
+const (
+	REPEAT_WORST    = 0 // failed run if there is one, otherwise the slowest
+	REPEAT_BEST     = 1 // successful run if there is one, otherwise the fastest
+	REPEAT_MAJORITY = 2 // run with median user time among the most common outcome
+)
+
+// Minimum, median and maximum of the measured value.
+type Range struct {
+	Min, Median, Max uint64
+}
+
+func rangeOf(values []uint64) Range {
+	sorted := append([]uint64(nil), values...)
+	sort.Sort(uint64s(sorted))
+	n := len(sorted)
+	return Range{
+		Min:    sorted[0],
+		Median: (sorted[(n-1)/2] + sorted[n/2]) / 2,
+		Max:    sorted[n-1],
+	}
+}
+
+type uint64s []uint64
+
+func (s uint64s) Len() int           { return len(s) }
+func (s uint64s) Less(i, j int) bool { return s[i] < s[j] }
+func (s uint64s) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+type RepeatResult struct {
+	*SubprocessResult // run chosen by the policy
+	Runs              []*SubprocessResult
+
+	// Times are in microseconds, memory is in bytes.
+	UserTime, WallTime, PeakMemory Range
+}
+
+// Input must be the same for every run, so streams and pipes can't be used. Pipes can't
+// be used for output either, as they are closed after the first run.
+func replayable(r *Redirect) bool {
+	if r == nil {
+		return true
+	}
+	switch r.Mode {
+	case REDIRECT_STREAM, REDIRECT_PIPE:
+		return false
+	case REDIRECT_PTY:
+		return r.Reader == nil
+	}
+	return true
+}
+
+// Redirects writing output to files. Every run writes them under its own name, and
+// the files of the chosen run are renamed over the requested ones.
+func outputFiles(sub *Subprocess) []*Redirect {
+	var result []*Redirect
+	for _, r := range []*Redirect{sub.StdIn, sub.StdOut, sub.StdErr} {
+		if r == nil || r.Filename == nil {
+			continue
+		}
+		if r.Mode != REDIRECT_PTY && (r == sub.StdIn || (r.Mode != REDIRECT_FILE && r.Mode != REDIRECT_TEE)) {
+			continue
+		}
+		seen := false
+		for _, prev := range result {
+			seen = seen || prev == r
+		}
+		if !seen {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+func runFileName(name string, run int) string {
+	return fmt.Sprintf("%s.run%d", name, run)
+}
+
+type outcome struct {
+	successCode, exitCode uint32
+}
+
+func (o outcome) failed() bool {
+	return o.successCode != 0 || o.exitCode != 0
+}
+
+func outcomeOf(r *SubprocessResult) outcome {
+	return outcome{r.SuccessCode, r.ExitCode}
+}
+
+func chooseRun(runs []*SubprocessResult, policy int) *SubprocessResult {
+	switch policy {
+	case REPEAT_BEST:
+		chosen := runs[0]
+		for _, r := range runs[1:] {
+			f, cf := outcomeOf(r).failed(), outcomeOf(chosen).failed()
+			if (cf && !f) || (f == cf && r.UserTime < chosen.UserTime) {
+				chosen = r
+			}
+		}
+		return chosen
+	case REPEAT_MAJORITY:
+		counts := make(map[outcome]int)
+		var major outcome
+		for _, r := range runs {
+			o := outcomeOf(r)
+			counts[o]++
+			// Ties are resolved in favour of failure.
+			if c := counts[o]; c > counts[major] || (c == counts[major] && o.failed() && !major.failed()) {
+				major = o
+			}
+		}
+		var group []*SubprocessResult
+		for _, r := range runs {
+			if outcomeOf(r) == major {
+				group = append(group, r)
+			}
+		}
+		sort.Sort(byUserTime(group))
+		return group[(len(group)-1)/2]
+	}
+
+	chosen := runs[0]
+	for _, r := range runs[1:] {
+		f, cf := outcomeOf(r).failed(), outcomeOf(chosen).failed()
+		if (f && !cf) || (f == cf && r.UserTime > chosen.UserTime) {
+			chosen = r
+		}
+	}
+	return chosen
+}
+
+type byUserTime []*SubprocessResult
+
+func (s byUserTime) Len() int           { return len(s) }
+func (s byUserTime) Less(i, j int) bool { return s[i].UserTime < s[j].UserTime }
+func (s byUserTime) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// Run the same subprocess n times, one after another. Every run is a new process,
+// and thus gets its own fresh cgroup. Stops at the first execution error.
+func (sub *Subprocess) Repeat(n, policy int) (*RepeatResult, error) {
+	return ExecuteRepeated(LocalExecutor{}, sub, n, policy)
+}
+
+// Same as Subprocess.Repeat, using the given executor.
+func ExecuteRepeated(e Executor, sub *Subprocess, n, policy int) (*RepeatResult, error) {
+	if n < 1 {
+		n = 1
+	}
+	if !replayable(sub.StdIn) {
+		return nil, errors.BadRequestf("Input can't be replayed for repeated runs")
+	}
+	if !replayable(sub.StdOut) || !replayable(sub.StdErr) {
+		return nil, errors.BadRequestf("Output can't be written to a pipe for repeated runs")
+	}
+
+	var outputs []*Redirect
+	var names []string
+	if n > 1 {
+		outputs = outputFiles(sub)
+		for _, r := range outputs {
+			names = append(names, *r.Filename)
+		}
+	}
+	defer func() {
+		for j, r := range outputs {
+			r.Filename = &names[j]
+			// Files of the runs that weren't chosen.
+			for i := 0; i < n; i++ {
+				os.Remove(runFileName(names[j], i))
+			}
+		}
+	}()
+
+	result := &RepeatResult{Runs: make([]*SubprocessResult, 0, n)}
+	userTimes, wallTimes, memory := make([]uint64, n), make([]uint64, n), make([]uint64, n)
+	for i := 0; i < n; i++ {
+		for j, r := range outputs {
+			name := runFileName(names[j], i)
+			r.Filename = &name
+		}
+		r, err := e.Execute(sub)
+		if err != nil {
+			return nil, err
+		}
+		result.Runs = append(result.Runs, r)
+		userTimes[i], wallTimes[i], memory[i] = GetMicros(r.UserTime), GetMicros(r.WallTime), r.PeakMemory
+	}
+
+	result.SubprocessResult = chooseRun(result.Runs, policy)
+	result.UserTime, result.WallTime, result.PeakMemory = rangeOf(userTimes), rangeOf(wallTimes), rangeOf(memory)
+
+	for i, r := range result.Runs {
+		if r != result.SubprocessResult {
+			continue
+		}
+		for j := range outputs {
+			if err := os.Rename(runFileName(names[j], i), names[j]); err != nil {
+				return nil, errors.Annotatef(err, "Keeping output of run %d", i)
+			}
+		}
+	}
+	return result, nil
+}
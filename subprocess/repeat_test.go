@@ -0,0 +1,131 @@
+// +build linux
+
+package subprocess
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestRangeOf(t *testing.T) {
+	for _, c := range []struct {
+		values   []uint64
+		expected Range
+	}{
+		{[]uint64{5}, Range{5, 5, 5}},
+		{[]uint64{3, 1, 2}, Range{1, 2, 3}},
+		{[]uint64{4, 1, 3, 2}, Range{1, 2, 4}},
+		{[]uint64{10, 10, 20, 30}, Range{10, 15, 30}},
+		{[]uint64{7, 7, 7}, Range{7, 7, 7}},
+	} {
+		if r := rangeOf(c.values); r != c.expected {
+			t.Errorf("rangeOf(%v) = %+v, expected %+v", c.values, r, c.expected)
+		}
+	}
+}
+
+func run(userMs int, successCode, exitCode uint32) *SubprocessResult {
+	return &SubprocessResult{
+		SuccessCode: successCode,
+		ExitCode:    exitCode,
+		TimeStats:   TimeStats{UserTime: time.Duration(userMs) * time.Millisecond},
+	}
+}
+
+func TestChooseRun(t *testing.T) {
+	ok1, ok2, ok3 := run(10, 0, 0), run(20, 0, 0), run(30, 0, 0)
+	tl, exit := run(50, EF_TIME_LIMIT_HIT, 0), run(5, 0, 1)
+
+	for _, c := range []struct {
+		runs     []*SubprocessResult
+		policy   int
+		expected *SubprocessResult
+	}{
+		{[]*SubprocessResult{ok1}, REPEAT_WORST, ok1},
+		{[]*SubprocessResult{ok1, ok3, ok2}, REPEAT_WORST, ok3},
+		{[]*SubprocessResult{ok1, ok3, exit}, REPEAT_WORST, exit},
+		{[]*SubprocessResult{exit, tl, ok1}, REPEAT_WORST, tl},
+		{[]*SubprocessResult{ok2, ok1, ok3}, REPEAT_BEST, ok1},
+		{[]*SubprocessResult{tl, ok3, exit}, REPEAT_BEST, ok3},
+		{[]*SubprocessResult{tl, exit}, REPEAT_BEST, exit},
+		{[]*SubprocessResult{ok3, ok1, ok2}, REPEAT_MAJORITY, ok2},
+		{[]*SubprocessResult{ok1, tl, ok2}, REPEAT_MAJORITY, ok1},
+		{[]*SubprocessResult{tl, ok1, tl}, REPEAT_MAJORITY, tl},
+		// Ties go to failure.
+		{[]*SubprocessResult{ok1, exit}, REPEAT_MAJORITY, exit},
+	} {
+		if r := chooseRun(c.runs, c.policy); r != c.expected {
+			t.Errorf("Policy %d chose %+v, expected %+v", c.policy, r, c.expected)
+		}
+	}
+}
+
+// Writes the number of the run to the stdout file, the second run fails.
+type countingExecutor struct {
+	runs int
+}
+
+func (e *countingExecutor) Execute(sub *Subprocess) (*SubprocessResult, error) {
+	e.runs++
+	if sub.StdOut != nil && sub.StdOut.Filename != nil {
+		if err := ioutil.WriteFile(*sub.StdOut.Filename, []byte(strconv.Itoa(e.runs)), 0644); err != nil {
+			return nil, err
+		}
+	}
+	if e.runs == 2 {
+		return run(e.runs, 0, 1), nil
+	}
+	return run(e.runs, 0, 0), nil
+}
+
+func TestExecuteRepeatedKeepsChosenOutput(t *testing.T) {
+	dir, err := ioutil.TempDir("", "repeat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "output.txt")
+	sub := SubprocessCreate()
+	sub.StdOut = &Redirect{Mode: REDIRECT_FILE, Filename: &name}
+
+	result, err := ExecuteRepeated(&countingExecutor{}, sub, 3, REPEAT_WORST)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Runs) != 3 || result.ExitCode != 1 {
+		t.Fatalf("Got %d runs, chosen exit code %d", len(result.Runs), result.ExitCode)
+	}
+	if data, err := ioutil.ReadFile(name); err != nil || string(data) != "2" {
+		t.Errorf("Output file has %q (%v), expected output of the second run", data, err)
+	}
+	if *sub.StdOut.Filename != name {
+		t.Errorf("Output file name is not restored: %s", *sub.StdOut.Filename)
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+		t.Errorf("%d files left, expected only the output", len(files))
+	}
+}
+
+func TestExecuteRepeatedRejectsPipes(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFiles([]*os.File{r, w})
+
+	for _, sub := range []*Subprocess{
+		{StdIn: &Redirect{Mode: REDIRECT_PIPE, Pipe: r}},
+		{StdOut: &Redirect{Mode: REDIRECT_PIPE, Pipe: w}},
+		{StdErr: &Redirect{Mode: REDIRECT_PIPE, Pipe: w}},
+	} {
+		e := &countingExecutor{}
+		if _, err := ExecuteRepeated(e, sub, 2, REPEAT_WORST); err == nil || e.runs != 0 {
+			t.Errorf("Repeated run with pipes wasn't rejected: %v, %d runs", err, e.runs)
+		}
+	}
+}
@@ -1,7 +1,6 @@
 package subprocess
 
 import (
-	"bytes"
 	"io"
 	"time"
 )
@@ -26,6 +25,9 @@ const (
 	REDIRECT_MEMORY = 1
 	REDIRECT_FILE   = 2
 	REDIRECT_PIPE   = 3
+	REDIRECT_STREAM = 4 // input only, copied from Redirect.Reader
+	REDIRECT_TEE    = 5 // output only, written to Redirect.Filename with head and tail kept in memory
+	REDIRECT_PTY    = 6 // linux only, pseudo-terminal shared by all descriptors with this mode
 )
 
 func GetMicros(d time.Duration) uint64 {
@@ -49,11 +51,17 @@ type SubprocessResult struct {
 
 	Output []byte
 	Error  []byte
+
+	// Set if Output or Error doesn't contain the whole stream (REDIRECT_TEE).
+	OutputTruncated, ErrorTruncated bool
 }
 
 type CommandLine struct {
 	ApplicationName, CommandLine *string
 	Parameters                   []string
+	// Look up ApplicationName without slashes in the PATH of the child environment.
+	// Linux only, CreateProcess does its own search.
+	SearchPath bool
 }
 
 // This structure defines all flags and options for starting a subprocess. It is not supposed to be modified by any
@@ -88,11 +96,15 @@ type SubprocessData struct {
 	bufferChan      chan error     // receives buffer errors
 	startAfterStart []func() error // buffer functions, launch after createFrozen
 	closeAfterStart []io.Closer    // close after createFrozen
+	closeOnFailure  []io.Closer    // parent ends owned by startAfterStart, close if it never runs
 
-	stdOut bytes.Buffer
-	stdErr bytes.Buffer
+	stdOut outputBuffer
+	stdErr outputBuffer
+	pty    *ptyState
 
 	platformData PlatformData
+
+	err error // set by BottomHalf if it failed to collect the result
 }
 
 func SubprocessCreate() *Subprocess {
@@ -121,6 +133,32 @@ func closeDescriptors(closers []io.Closer) {
 	}
 }
 
+// Create the process, suspended. If that fails, everything set up for its redirects
+// is released.
+func (sub *Subprocess) CreateFrozen() (*SubprocessData, error) {
+	d := &SubprocessData{}
+	result, err := sub.createFrozen(d)
+	if err != nil {
+		d.discard(sub)
+	}
+	return result, err
+}
+
+// Close both ends of the redirects of the process that was never started.
+func (d *SubprocessData) discard(sub *Subprocess) {
+	closeDescriptors(d.closeAfterStart)
+	closeDescriptors(d.closeOnFailure)
+	sub.ReleaseRedirects()
+}
+
+// Close the input readers and pipes of the redirects. Must be called if the subprocess
+// is given up on before Execute, which closes them otherwise.
+func (sub *Subprocess) ReleaseRedirects() {
+	for _, r := range []*Redirect{sub.StdIn, sub.StdOut, sub.StdErr} {
+		r.release()
+	}
+}
+
 func (sub *Subprocess) Execute() (*SubprocessResult, error) {
 	// Locking of the OS thread is needed on linux, because PtraceDetach will not work if you do it from the
 	// different thread.
@@ -133,18 +171,22 @@ func (sub *Subprocess) Execute() (*SubprocessResult, error) {
 	}
 
 	if err = d.SetupRedirectionBuffers(); err != nil {
+		d.abort(sub)
 		return nil, err // we must die here
 	}
 
-	d.Unfreeze()
+	if err = d.Unfreeze(); err != nil {
+		d.abort(sub)
+		return nil, err
+	}
 	sig := make(chan *SubprocessResult, 1)
 	go sub.BottomHalf(d, sig)
 
-	//if err = d.Unfreeze(); err != nil {
-	//	return nil, err
-	//}
-
-	return <-sig, nil
+	result := <-sig
+	if d.err != nil {
+		return nil, d.err
+	}
+	return result, nil
 }
 
 type runningState struct {
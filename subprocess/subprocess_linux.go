@@ -1,7 +1,7 @@
 package subprocess
 
 import (
-	"fmt"
+	"os"
 	"os/user"
 	"runtime"
 	"strconv"
@@ -23,8 +23,11 @@ type PlatformOptions struct {
 
 type PlatformData struct {
 	Pid       int
+	Pidfd     int // -1 if the kernel doesn't support pidfds
 	params    *linux.CloneParams
 	startTime time.Time
+
+	waitByPid bool // P_PIDFD is not supported, only touched by the waiting goroutine
 }
 
 func NewLoginInfo(username, password string) (*LoginInfo, error) {
@@ -54,18 +57,21 @@ func (d *SubprocessData) wAllRedirects(s *Subprocess, result *linux.StdHandles)
 	if result.StdErr, err = d.SetupOutput(s.StdErr, &d.stdErr); err != nil {
 		return err
 	}
+	if d.pty != nil {
+		result.Terminal = d.pty.slave
+	}
 	return nil
 }
 
-func (sub *Subprocess) CreateFrozen() (*SubprocessData, error) {
+func (sub *Subprocess) createFrozen(d *SubprocessData) (*SubprocessData, error) {
 	ec := tools.ErrorContext("CreateFrozen")
 
-	if sub.Cmd.ApplicationName == nil {
-		return nil, ec.NewError(fmt.Errorf("Application name must be present"), "init")
+	filename, argv, err := sub.Cmd.resolve(sub.Environment, sub.CurrentDirectory)
+	if err != nil {
+		return nil, err
 	}
-	d := &SubprocessData{}
 	var stdh linux.StdHandles
-	err := d.wAllRedirects(sub, &stdh)
+	err = d.wAllRedirects(sub, &stdh)
 	defer stdh.Close()
 	if err != nil {
 		return nil, ec.NewError(err, "Redirects")
@@ -75,7 +81,7 @@ func (sub *Subprocess) CreateFrozen() (*SubprocessData, error) {
 		uid = sub.Login.Uid
 	}
 	d.platformData.params, err = linux.CreateCloneParams(
-		*sub.Cmd.ApplicationName, sub.Cmd.Parameters, sub.Environment, sub.CurrentDirectory, uid, stdh)
+		filename, argv, sub.Environment, sub.CurrentDirectory, uid, stdh)
 	if err != nil {
 		return nil, ec.NewError(err, "CreateCloneParams")
 	}
@@ -84,15 +90,42 @@ func (sub *Subprocess) CreateFrozen() (*SubprocessData, error) {
 	closeDescriptors(d.closeAfterStart)
 	syscall.ForkLock.Unlock()
 	if err != nil {
+		if ce, ok := err.(*linux.ChildError); ok {
+			return nil, newSetupError(sub, filename, ce)
+		}
 		return nil, ec.NewError(err, "CloneFrozen")
 	}
+	// Child is traced and stopped, so its pid can't be reused until we reap it.
+	if d.platformData.Pidfd, err = linux.PidfdOpen(d.platformData.Pid); err != nil {
+		log.Debugf("Falling back to pid-based process management: %s", err)
+		d.platformData.Pidfd = -1
+	}
 	err = SetupControlGroup(sub, d)
 	if err != nil {
+		d.abort(sub)
 		return nil, ec.NewError(err, "SetupControlGroup")
 	}
 	return d, nil
 }
 
+// Kill and reap the frozen child if it can't be started, releasing its pidfd and cgroup.
+func (d *SubprocessData) abort(sub *Subprocess) {
+	p := &d.platformData
+	p.signal(syscall.SIGKILL)
+	var status syscall.WaitStatus
+	for {
+		_, err := syscall.Wait4(p.Pid, &status, 0, nil)
+		if err == syscall.EINTR {
+			continue
+		}
+		if err != nil || status.Exited() || status.Signaled() {
+			break
+		}
+	}
+	p.closePidfd()
+	sub.Options.Cg.Remove(strconv.Itoa(p.Pid))
+}
+
 func SetupControlGroup(s *Subprocess, d *SubprocessData) error {
 	cgname := strconv.Itoa(d.platformData.Pid)
 	s.Options.Cg.Setup(cgname, d.platformData.Pid)
@@ -110,16 +143,60 @@ type ChildWaitData struct {
 	StopSignal                     uint32
 	KillSignal                     uint32
 	RusageCpuUser, RusageCpuKernel time.Duration
+	Err                            error
+}
+
+// Send signal to the child, via pidfd if possible.
+func (p *PlatformData) signal(sig syscall.Signal) error {
+	if p.Pidfd >= 0 {
+		return linux.PidfdSendSignal(p.Pidfd, sig)
+	}
+	return syscall.Kill(p.Pid, sig)
+}
+
+func (p *PlatformData) closePidfd() {
+	if p.Pidfd >= 0 {
+		syscall.Close(p.Pidfd)
+		p.Pidfd = -1
+	}
 }
 
-func ChildWaitingFunc(pid int, sig chan *ChildWaitData) {
+// Wait for the next state change of the child.
+func (p *PlatformData) wait(status *syscall.WaitStatus, rusage *syscall.Rusage) error {
+	if p.Pidfd >= 0 && !p.waitByPid {
+		st, err := linux.PidfdWait(p.Pidfd, syscall.WEXITED|syscall.WSTOPPED|syscall.WCONTINUED, rusage)
+		if err == nil {
+			*status = st
+			return nil
+		}
+		if errno, ok := extractErrno(err); !ok || errno != syscall.EINVAL {
+			return err
+		}
+		// Kernel has pidfd_open, but no P_PIDFD.
+		p.waitByPid = true
+	}
+	for {
+		wpid, err := syscall.Wait4(p.Pid, status, syscall.WUNTRACED|syscall.WCONTINUED, rusage)
+		if err == syscall.EINTR {
+			continue
+		}
+		if err != nil {
+			return os.NewSyscallError("wait4", err)
+		}
+		if wpid == p.Pid {
+			return nil
+		}
+	}
+}
+
+func ChildWaitingFunc(p *PlatformData, sig chan *ChildWaitData) {
 	var status syscall.WaitStatus
 	var rusage syscall.Rusage
 	result := &ChildWaitData{}
 	for {
-		wpid, err := syscall.Wait4(pid, &status, syscall.WUNTRACED|syscall.WCONTINUED, &rusage)
-		if wpid != pid {
-			continue
+		if err := p.wait(&status, &rusage); err != nil {
+			result.Err = err
+			break
 		}
 
 		if status.Exited() {
@@ -129,16 +206,13 @@ func ChildWaitingFunc(pid int, sig chan *ChildWaitData) {
 		if status.Stopped() {
 			result.SuccessCode |= EF_STOPPED
 			result.StopSignal = uint32(status.StopSignal())
-			syscall.Kill(pid, syscall.SIGKILL)
+			p.signal(syscall.SIGKILL)
 		}
 		if status.Signaled() {
 			result.SuccessCode |= EF_KILLED_BY_OTHER
 			result.KillSignal = uint32(status.Signal())
 			break
 		}
-		if err != nil {
-			break
-		}
 	}
 	result.RusageCpuUser = time.Nanosecond * time.Duration(rusage.Utime.Nano())
 	result.RusageCpuKernel = time.Nanosecond * time.Duration(rusage.Stime.Nano())
@@ -156,7 +230,7 @@ func (sub *Subprocess) BottomHalf(d *SubprocessData, sig chan *SubprocessResult)
 	result := &SubprocessResult{}
 
 	childChan := make(chan *ChildWaitData, 1)
-	go ChildWaitingFunc(d.platformData.Pid, childChan)
+	go ChildWaitingFunc(&d.platformData, childChan)
 	ticker := time.NewTicker(sub.TimeQuantum)
 	var finished *ChildWaitData
 	var runState runningState
@@ -174,10 +248,16 @@ W:
 	ticker.Stop()
 	if finished == nil {
 		result.SuccessCode |= EF_KILLED
-		syscall.Kill(d.platformData.Pid, syscall.SIGKILL)
+		d.platformData.signal(syscall.SIGKILL)
 		// Can block if process is unkillable.
 		finished = <-childChan
 	}
+	if finished.Err != nil {
+		// We've lost track of the child; make sure it doesn't outlive us.
+		d.platformData.signal(syscall.SIGKILL)
+		d.err = finished.Err
+	}
+	d.platformData.closePidfd()
 	UpdateRunningUsage(&d.platformData, sub.Options, result)
 	sub.Options.Cg.Remove(strconv.Itoa(d.platformData.Pid))
 	result.ExitCode = finished.ExitCode
@@ -198,6 +278,7 @@ W:
 	if d.stdErr.Len() > 0 {
 		result.Error = d.stdErr.Bytes()
 	}
+	result.OutputTruncated, result.ErrorTruncated = d.stdOut.Truncated, d.stdErr.Truncated
 	sig <- result
 	close(sig)
 }
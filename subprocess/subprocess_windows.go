@@ -1,7 +1,6 @@
 package subprocess
 
 import (
-	"bytes"
 	"fmt"
 	"os"
 	"syscall"
@@ -49,7 +48,7 @@ func NewLoginInfo(username, password string) (*LoginInfo, error) {
 // 4. unfreeze
 // 5. wait
 
-func (d *SubprocessData) wOutputRedirect(w *Redirect, b *bytes.Buffer) (syscall.Handle, error) {
+func (d *SubprocessData) wOutputRedirect(w *Redirect, b *outputBuffer) (syscall.Handle, error) {
 	f, err := d.SetupOutput(w, b)
 	if err != nil || f == nil {
 		return syscall.InvalidHandle, err
@@ -131,8 +130,16 @@ func (d *PlatformData) terminateAndClose() (err error) {
 	return
 }
 
-func (sub *Subprocess) CreateFrozen() (*SubprocessData, error) {
-	d := &SubprocessData{}
+// Terminate the suspended process if it can't be started.
+func (d *SubprocessData) abort(sub *Subprocess) {
+	d.platformData.terminateAndClose()
+	if d.platformData.hJob != syscall.InvalidHandle {
+		win32.CloseHandle(d.platformData.hJob)
+		d.platformData.hJob = syscall.InvalidHandle
+	}
+}
+
+func (sub *Subprocess) createFrozen(d *SubprocessData) (*SubprocessData, error) {
 
 	si := &syscall.StartupInfo{}
 	si.Cb = uint32(unsafe.Sizeof(*si))
@@ -220,9 +227,17 @@ func (sub *Subprocess) CreateFrozen() (*SubprocessData, error) {
 	syscall.ForkLock.Unlock()
 
 	if e != nil {
-		if errno, ok := extractErrno(e); ok && errno == 136 {
+		errno, ok := extractErrno(e)
+		switch {
+		case ok && errno == 136:
 			e = errors.NewBadRequest(e, "errno 136")
-		} else {
+		case ok:
+			se := &SetupError{Stage: "CreateProcess", Errno: errno}
+			if sub.Cmd.ApplicationName != nil {
+				se.Path = *sub.Cmd.ApplicationName
+			}
+			e = se
+		default:
 			e = errors.Trace(e)
 		}
 		return nil, e
@@ -546,6 +561,7 @@ func (sub *Subprocess) BottomHalf(d *SubprocessData, sig chan<- *SubprocessResul
 	if d.stdErr.Len() > 0 {
 		result.Error = d.stdErr.Bytes()
 	}
+	result.OutputTruncated, result.ErrorTruncated = d.stdOut.Truncated, d.stdErr.Truncated
 
 	sig <- result
 }
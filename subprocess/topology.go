@@ -0,0 +1,153 @@
+package subprocess
+
+import (
+	"io"
+	"os"
+	"sync"
+
+	"github.com/juju/errors"
+)
+
+const (
+	FD_STDIN  = 0
+	FD_STDOUT = 1
+	FD_STDERR = 2
+)
+
+// Edge connects output descriptor (FD_STDOUT or FD_STDERR) of the process From
+// to the input descriptor (FD_STDIN) of the process To. If Record is not nil, everything
+// passing through the edge is also written to it.
+type Edge struct {
+	From, FromFd int
+	To, ToFd     int
+	Record       io.Writer
+}
+
+// Topology is a set of subprocesses, connected by pipes according to Edges.
+// Descriptors not mentioned in any edge keep their redirects, the redirects of connected
+// ones are released and replaced.
+type Topology struct {
+	Processes []*Subprocess
+	Edges     []Edge
+	Executor  Executor // LocalExecutor if nil
+
+	recorders sync.WaitGroup
+}
+
+func (t *Topology) validate() error {
+	outputs := make(map[[2]int]bool)
+	inputs := make(map[[2]int]bool)
+	for _, e := range t.Edges {
+		if e.From < 0 || e.From >= len(t.Processes) || e.To < 0 || e.To >= len(t.Processes) {
+			return errors.BadRequestf("Edge %d:%d -> %d:%d references unknown process", e.From, e.FromFd, e.To, e.ToFd)
+		}
+		if e.FromFd != FD_STDOUT && e.FromFd != FD_STDERR {
+			return errors.BadRequestf("Edge source descriptor %d is not an output", e.FromFd)
+		}
+		if e.ToFd != FD_STDIN {
+			return errors.BadRequestf("Edge destination descriptor %d is not an input", e.ToFd)
+		}
+		if e.FromFd == FD_STDERR && t.Processes[e.From].JoinStdOutErr {
+			return errors.BadRequestf("Process %d has stderr joined with stdout", e.From)
+		}
+		from, to := [2]int{e.From, e.FromFd}, [2]int{e.To, e.ToFd}
+		if outputs[from] {
+			return errors.BadRequestf("Descriptor %d of process %d is connected twice", e.FromFd, e.From)
+		}
+		if inputs[to] {
+			return errors.BadRequestf("Descriptor %d of process %d is connected twice", e.ToFd, e.To)
+		}
+		outputs[from], inputs[to] = true, true
+	}
+	return nil
+}
+
+// Replace the redirect of the descriptor, releasing the old one.
+func setRedirect(s *Subprocess, fd int, r *Redirect) {
+	switch fd {
+	case FD_STDIN:
+		s.StdIn.release()
+		s.StdIn = r
+	case FD_STDOUT:
+		s.StdOut.release()
+		s.StdOut = r
+	case FD_STDERR:
+		s.StdErr.release()
+		s.StdErr = r
+	}
+}
+
+// Create pipes for all edges and set up redirects of the processes to use them.
+func (t *Topology) Connect() error {
+	if err := t.validate(); err != nil {
+		return err
+	}
+
+	var created []*os.File
+	for _, e := range t.Edges {
+		read, write, err := recordingPipe(e.Record, &t.recorders)
+		if err != nil {
+			closeFiles(created)
+			return err
+		}
+		created = append(created, read, write)
+
+		setRedirect(t.Processes[e.To], e.ToFd, &Redirect{
+			Mode: REDIRECT_PIPE,
+			Pipe: read,
+		})
+		setRedirect(t.Processes[e.From], e.FromFd, &Redirect{
+			Mode: REDIRECT_PIPE,
+			Pipe: write,
+		})
+	}
+	return nil
+}
+
+// Release redirects of all processes, if the topology is not going to be executed.
+func (t *Topology) Release() {
+	for _, sub := range t.Processes {
+		if sub != nil {
+			sub.ReleaseRedirects()
+		}
+	}
+}
+
+func closeFiles(files []*os.File) {
+	for _, f := range files {
+		f.Close()
+	}
+}
+
+type topologyResult struct {
+	index  int
+	result *SubprocessResult
+	err    error
+}
+
+// Run all processes concurrently and wait for all of them to finish, and for
+// recorders to receive all the data. Results and errors are indexed the same way as Processes.
+func (t *Topology) Execute() ([]*SubprocessResult, []error) {
+	results := make([]*SubprocessResult, len(t.Processes))
+	errs := make([]error, len(t.Processes))
+
+	executor := t.Executor
+	if executor == nil {
+		executor = LocalExecutor{}
+	}
+
+	c := make(chan topologyResult, len(t.Processes))
+	for i, sub := range t.Processes {
+		go func(i int, sub *Subprocess) {
+			r, err := executor.Execute(sub)
+			c <- topologyResult{index: i, result: r, err: err}
+		}(i, sub)
+	}
+
+	for range t.Processes {
+		r := <-c
+		results[r.index], errs[r.index] = r.result, r.err
+	}
+	t.recorders.Wait()
+	return results, errs
+}
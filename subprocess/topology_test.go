@@ -0,0 +1,183 @@
+// +build linux
+
+package subprocess
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTopologyValidate(t *testing.T) {
+	for _, c := range []struct {
+		edges  []Edge
+		joined bool
+		valid  bool
+	}{
+		{nil, false, true},
+		{[]Edge{{From: 0, FromFd: FD_STDOUT, To: 1, ToFd: FD_STDIN}}, false, true},
+		{[]Edge{{From: 0, FromFd: FD_STDERR, To: 1, ToFd: FD_STDIN}}, false, true},
+		{[]Edge{{From: 0, FromFd: FD_STDERR, To: 1, ToFd: FD_STDIN}}, true, false},
+		{[]Edge{{From: 0, FromFd: FD_STDOUT, To: 2, ToFd: FD_STDIN}}, false, false},
+		{[]Edge{{From: -1, FromFd: FD_STDOUT, To: 1, ToFd: FD_STDIN}}, false, false},
+		{[]Edge{{From: 0, FromFd: FD_STDIN, To: 1, ToFd: FD_STDIN}}, false, false},
+		{[]Edge{{From: 0, FromFd: FD_STDOUT, To: 1, ToFd: FD_STDOUT}}, false, false},
+		{[]Edge{
+			{From: 0, FromFd: FD_STDOUT, To: 1, ToFd: FD_STDIN},
+			{From: 0, FromFd: FD_STDOUT, To: 0, ToFd: FD_STDIN},
+		}, false, false},
+		{[]Edge{
+			{From: 0, FromFd: FD_STDOUT, To: 1, ToFd: FD_STDIN},
+			{From: 1, FromFd: FD_STDOUT, To: 1, ToFd: FD_STDIN},
+		}, false, false},
+		{[]Edge{
+			{From: 0, FromFd: FD_STDOUT, To: 1, ToFd: FD_STDIN},
+			{From: 1, FromFd: FD_STDOUT, To: 0, ToFd: FD_STDIN},
+		}, false, true},
+	} {
+		first := SubprocessCreate()
+		first.JoinStdOutErr = c.joined
+		topology := &Topology{Processes: []*Subprocess{first, SubprocessCreate()}, Edges: c.edges}
+		if err := topology.validate(); (err == nil) != c.valid {
+			t.Errorf("%+v (joined %v): got %v, expected valid %v", c.edges, c.joined, err, c.valid)
+		}
+	}
+}
+
+// Copies stdin, if it is a pipe, to stdout with the name of the process appended.
+type pipeExecutor struct {
+	names map[*Subprocess]string
+}
+
+func (e pipeExecutor) Execute(sub *Subprocess) (*SubprocessResult, error) {
+	var input []byte
+	if sub.StdIn != nil && sub.StdIn.Mode == REDIRECT_PIPE {
+		input, _ = ioutil.ReadAll(sub.StdIn.Pipe)
+		sub.StdIn.Pipe.Close()
+	}
+	if sub.StdOut != nil && sub.StdOut.Mode == REDIRECT_PIPE {
+		sub.StdOut.Pipe.Write(append(input, e.names[sub]...))
+		sub.StdOut.Pipe.Close()
+	}
+	return &SubprocessResult{Output: input}, nil
+}
+
+func TestTopologyExecute(t *testing.T) {
+	processes := []*Subprocess{SubprocessCreate(), SubprocessCreate(), SubprocessCreate()}
+	executor := pipeExecutor{names: map[*Subprocess]string{processes[0]: "a", processes[1]: "b", processes[2]: "c"}}
+	var recorded bytes.Buffer
+	topology := &Topology{
+		Processes: processes,
+		Edges: []Edge{
+			{From: 0, FromFd: FD_STDOUT, To: 1, ToFd: FD_STDIN},
+			{From: 1, FromFd: FD_STDOUT, To: 2, ToFd: FD_STDIN, Record: &recorded},
+		},
+		Executor: executor,
+	}
+	if err := topology.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	for i, fd := range [][2]*Redirect{{nil, processes[0].StdOut}, {processes[1].StdIn, processes[1].StdOut}, {processes[2].StdIn, nil}} {
+		for _, r := range fd {
+			if r != nil && r.Mode != REDIRECT_PIPE {
+				t.Errorf("Process %d has redirect mode %d, expected pipe", i, r.Mode)
+			}
+		}
+	}
+
+	results, errs := topology.Execute()
+	for i, expected := range []string{"", "a", "ab"} {
+		if errs[i] != nil {
+			t.Fatalf("Process %d: %s", i, errs[i])
+		}
+		if string(results[i].Output) != expected {
+			t.Errorf("Process %d got %q, expected %q", i, results[i].Output, expected)
+		}
+	}
+	if recorded.String() != "ab" {
+		t.Errorf("Recorded %q, expected %q", recorded.String(), "ab")
+	}
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestTopologyConnectReleasesStream(t *testing.T) {
+	stream := &closeRecorder{Reader: strings.NewReader("input")}
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	first, second := SubprocessCreate(), SubprocessCreate()
+	first.StdErr = &Redirect{Mode: REDIRECT_PIPE, Pipe: w}
+	second.StdIn = &Redirect{Mode: REDIRECT_STREAM, Reader: stream}
+	topology := &Topology{
+		Processes: []*Subprocess{first, second},
+		Edges:     []Edge{{From: 0, FromFd: FD_STDERR, To: 1, ToFd: FD_STDIN}},
+	}
+	if err := topology.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer closeFiles([]*os.File{first.StdErr.Pipe, second.StdIn.Pipe})
+	if !stream.closed {
+		t.Errorf("Stream replaced by the edge is not closed")
+	}
+	// Reading gets EOF once the only writer is closed.
+	if n, err := r.Read(make([]byte, 1)); n != 0 || err != io.EOF {
+		t.Errorf("Pipe replaced by the edge is not closed: %d, %v", n, err)
+	}
+}
+
+// Runs the given process for real, the others with pipeExecutor.
+type mixedExecutor struct {
+	pipeExecutor
+	local *Subprocess
+}
+
+func (e mixedExecutor) Execute(sub *Subprocess) (*SubprocessResult, error) {
+	if sub == e.local {
+		return LocalExecutor{}.Execute(sub)
+	}
+	return e.pipeExecutor.Execute(sub)
+}
+
+func TestTopologyCreateFailureReleasesPipes(t *testing.T) {
+	name := "no-such-binary-anywhere"
+	missing := SubprocessCreate()
+	missing.Cmd = &CommandLine{CommandLine: &name, SearchPath: true}
+	missing.Environment = &[]string{"PATH=/nonexistent"}
+	other := SubprocessCreate()
+
+	var input, output bytes.Buffer
+	topology := Interconnected(missing, other, &input, &output)
+	topology.Executor = mixedExecutor{pipeExecutor{names: map[*Subprocess]string{other: "b"}}, missing}
+	if err := topology.Connect(); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan []error, 1)
+	go func() {
+		_, errs := topology.Execute()
+		done <- errs
+	}()
+	select {
+	case errs := <-done:
+		if !IsUserError(errs[0]) || errs[1] != nil {
+			t.Errorf("Unexpected errors %v", errs)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("Execute hangs after the process failed to start")
+	}
+}
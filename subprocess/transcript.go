@@ -0,0 +1,134 @@
+package subprocess
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// Direction tags used for interconnected processes, relative to the first (program) process.
+const (
+	TRANSCRIPT_INPUT     = "in"
+	TRANSCRIPT_OUTPUT    = "out"
+	TRANSCRIPT_TRUNCATED = "truncated"
+)
+
+// One chunk of recorded data. Transcript is stored as JSON lines, one entry per line;
+// Data is base64-encoded by encoding/json.
+type TranscriptEntry struct {
+	Micros    int64  `json:"t"`
+	Direction string `json:"d"`
+	Data      []byte `json:"data,omitempty"`
+}
+
+// Transcript merges several recorded streams into one log, tagging every chunk with
+// the time since the transcript was created (monotonic) and its direction.
+type Transcript struct {
+	// If non-zero, stop recording when the log would grow larger than this.
+	MaxSize int64
+
+	mu        sync.Mutex
+	w         io.Writer
+	start     time.Time
+	size      int64
+	truncated bool
+	err       error
+}
+
+func NewTranscript(w io.Writer) *Transcript {
+	return &Transcript{
+		w:     w,
+		start: time.Now(),
+	}
+}
+
+type transcriptWriter struct {
+	t         *Transcript
+	direction string
+}
+
+// Never fails, so that broken transcript doesn't break the pipe it records.
+func (w *transcriptWriter) Write(p []byte) (int, error) {
+	w.t.record(w.direction, p)
+	return len(p), nil
+}
+
+// Returns writer which records everything written to it with the given direction tag.
+func (t *Transcript) Writer(direction string) io.Writer {
+	return &transcriptWriter{t: t, direction: direction}
+}
+
+func (t *Transcript) Truncated() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.truncated
+}
+
+// First error encountered while writing the log.
+func (t *Transcript) Err() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.err
+}
+
+func (t *Transcript) record(direction string, data []byte) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.truncated || t.err != nil {
+		return
+	}
+
+	entry := TranscriptEntry{
+		Micros:    int64(GetMicros(time.Since(t.start))),
+		Direction: direction,
+		Data:      data,
+	}
+	line, _ := json.Marshal(&entry)
+	line = append(line, '\n')
+
+	if t.MaxSize > 0 && t.size+int64(len(line)) > t.MaxSize {
+		t.truncated = true
+		entry.Direction, entry.Data = TRANSCRIPT_TRUNCATED, nil
+		line, _ = json.Marshal(&entry)
+		line = append(line, '\n')
+	}
+	n, err := t.w.Write(line)
+	t.size += int64(n)
+	t.err = err
+}
+
+// Read transcript entries until EOF, calling fn for each of them.
+func ReadTranscript(r io.Reader, fn func(*TranscriptEntry) error) error {
+	decoder := json.NewDecoder(r)
+	for {
+		var entry TranscriptEntry
+		if err := decoder.Decode(&entry); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := fn(&entry); err != nil {
+			return err
+		}
+	}
+}
+
+// Combine recorders into one, skipping nil ones. Returns nil if there's nothing to record to.
+func JoinRecorders(writers ...io.Writer) io.Writer {
+	var result []io.Writer
+	for _, w := range writers {
+		if w != nil {
+			result = append(result, w)
+		}
+	}
+	switch len(result) {
+	case 0:
+		return nil
+	case 1:
+		return result[0]
+	}
+	return io.MultiWriter(result...)
+}
@@ -0,0 +1,83 @@
+// +build linux
+
+package subprocess
+
+import (
+	"bytes"
+	"testing"
+)
+
+func readEntries(t *testing.T, data []byte) []TranscriptEntry {
+	var result []TranscriptEntry
+	err := ReadTranscript(bytes.NewReader(data), func(e *TranscriptEntry) error {
+		result = append(result, *e)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func TestTranscript(t *testing.T) {
+	var buf bytes.Buffer
+	transcript := NewTranscript(&buf)
+	input, output := transcript.Writer(TRANSCRIPT_INPUT), transcript.Writer(TRANSCRIPT_OUTPUT)
+	input.Write([]byte("1 2\n"))
+	output.Write([]byte("3\n"))
+
+	entries := readEntries(t, buf.Bytes())
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %v", entries)
+	}
+	for i, expected := range []TranscriptEntry{{Direction: TRANSCRIPT_INPUT, Data: []byte("1 2\n")}, {Direction: TRANSCRIPT_OUTPUT, Data: []byte("3\n")}} {
+		if entries[i].Direction != expected.Direction || !bytes.Equal(entries[i].Data, expected.Data) {
+			t.Errorf("Entry %d: expected %+v, got %+v", i, expected, entries[i])
+		}
+	}
+	if entries[1].Micros < entries[0].Micros {
+		t.Errorf("Timestamps go backwards: %v", entries)
+	}
+	if transcript.Truncated() || transcript.Err() != nil {
+		t.Errorf("Unexpected truncation or error %v", transcript.Err())
+	}
+}
+
+func TestTranscriptTruncated(t *testing.T) {
+	var buf bytes.Buffer
+	transcript := NewTranscript(&buf)
+	transcript.MaxSize = 100
+	w := transcript.Writer(TRANSCRIPT_OUTPUT)
+	for i := 0; i < 10; i++ {
+		if n, err := w.Write([]byte("0123456789")); n != 10 || err != nil {
+			t.Fatalf("Recording writer failed: %d, %v", n, err)
+		}
+	}
+
+	if !transcript.Truncated() {
+		t.Error("Transcript is not truncated")
+	}
+	entries := readEntries(t, buf.Bytes())
+	if len(entries) == 0 || entries[len(entries)-1].Direction != TRANSCRIPT_TRUNCATED {
+		t.Errorf("Expected truncation mark at the end, got %v", entries)
+	}
+	for _, e := range entries[:len(entries)-1] {
+		if e.Direction != TRANSCRIPT_OUTPUT {
+			t.Errorf("Unexpected entry %+v", e)
+		}
+	}
+}
+
+func TestJoinRecorders(t *testing.T) {
+	if JoinRecorders(nil, nil) != nil {
+		t.Error("Expected nil recorder")
+	}
+	var a, b bytes.Buffer
+	if w := JoinRecorders(nil, &a); w != &a {
+		t.Error("Single recorder must be returned as is")
+	}
+	JoinRecorders(&a, nil, &b).Write([]byte("x"))
+	if a.String() != "x" || b.String() != "x" {
+		t.Errorf("Recorded %q and %q", a.String(), b.String())
+	}
+}
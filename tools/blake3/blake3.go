@@ -0,0 +1,17 @@
+// Package blake3 registers the blake3 checksum algorithm. It is kept apart from tools and
+// contester_proto, which only depend on the standard library, and is linked into the
+// service only. Depends on lukechampine.com/blake3 v1.2.1.
+package blake3
+
+import (
+	"hash"
+
+	"github.com/taskcluster/runlib/contester_proto"
+	"lukechampine.com/blake3"
+)
+
+func init() {
+	contester_proto.RegisterHash(contester_proto.CHECKSUM_BLAKE3, func() hash.Hash {
+		return blake3.New(32, nil)
+	})
+}
@@ -2,19 +2,26 @@ package tools
 
 import (
 	"crypto/sha1"
-	"encoding/hex"
 	"io"
 	"os"
-	"strings"
+
 	"github.com/juju/errors"
+	"github.com/taskcluster/runlib/contester_proto"
 )
 
 func HashFileString(name string) (string, error) {
-	computedHash, err := HashFile(name)
+	return ChecksumFile(name, contester_proto.CHECKSUM_SHA1)
+}
+
+// Checksum of the file as algo:hex.
+func ChecksumFile(name, algo string) (string, error) {
+	source, err := os.Open(name)
 	if err != nil {
-		return "", err
+		return "", errors.Annotatef(err, "os.Open(%q)", name)
 	}
-	return "sha1:" + strings.ToLower(hex.EncodeToString(computedHash)), nil
+	defer source.Close()
+
+	return contester_proto.CalcChecksum(algo, source)
 }
 
 func HashFile(name string) ([]byte, error) {
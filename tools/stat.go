@@ -8,10 +8,8 @@ import (
 	"github.com/taskcluster/runlib/contester_proto"
 )
 
-func StatFile(name string, hash_it bool) (*contester_proto.FileStat, error) {
-	result := contester_proto.FileStat{
-		Name: &name,
-	}
+// Stat the file, calculating its checksum if the algorithm is given.
+func StatFile(name, algo string) (*contester_proto.FileStat, error) {
 	info, err := os.Stat(name)
 	if err != nil {
 		// Handle ERROR_FILE_NOT_FOUND - return no error and nil instead of stat struct
@@ -21,12 +19,43 @@ func StatFile(name string, hash_it bool) (*contester_proto.FileStat, error) {
 
 		return nil, errors.Annotatef(err, "os.Stat(%q)", name)
 	}
-	if info.IsDir() {
+	return statInfo(name, info, algo)
+}
+
+// Same as StatFile, but symlinks are reported with their target instead of being followed.
+func LstatFile(name, algo string) (*contester_proto.FileStat, error) {
+	info, err := os.Lstat(name)
+	if err != nil {
+		if IsStatErrorFileNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, errors.Annotatef(err, "os.Lstat(%q)", name)
+	}
+	return statInfo(name, info, algo)
+}
+
+func statInfo(name string, info os.FileInfo, algo string) (*contester_proto.FileStat, error) {
+	result := contester_proto.FileStat{
+		Name:        &name,
+		Mode:        proto.Uint32(uint32(info.Mode().Perm())),
+		MtimeMicros: proto.Int64(info.ModTime().UnixNano() / 1000),
+	}
+	fillOwner(&result, info)
+
+	switch {
+	case info.IsDir():
 		result.IsDirectory = proto.Bool(true)
-	} else {
+	case info.Mode()&os.ModeSymlink != 0:
+		target, err := os.Readlink(name)
+		if err != nil {
+			return nil, errors.Annotatef(err, "os.Readlink(%q)", name)
+		}
+		result.SymlinkTarget = &target
+	default:
 		result.Size_ = proto.Uint64(uint64(info.Size()))
-		if hash_it {
-			checksum, err := HashFileString(name)
+		if algo != "" {
+			checksum, err := ChecksumFile(name, algo)
 			if err != nil {
 				return nil, err
 			}
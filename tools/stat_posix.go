@@ -0,0 +1,18 @@
+// +build linux darwin
+
+package tools
+
+import (
+	"os"
+	"syscall"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/taskcluster/runlib/contester_proto"
+)
+
+func fillOwner(result *contester_proto.FileStat, info os.FileInfo) {
+	if st, ok := info.Sys().(*syscall.Stat_t); ok {
+		result.Uid = proto.Uint32(st.Uid)
+		result.Gid = proto.Uint32(st.Gid)
+	}
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/taskcluster/runlib/subprocess"
+)
+
+// Prints transcript recorded by runexe -transcript or LocalExecuteConnected in a readable form:
+// one line per chunk, with time in milliseconds, direction and quoted data.
+func printTranscript(r io.Reader, w io.Writer) error {
+	return subprocess.ReadTranscript(r, func(e *subprocess.TranscriptEntry) error {
+		_, err := fmt.Fprintf(w, "%10.3f %-3s %q\n", float64(e.Micros)/1000, e.Direction, e.Data)
+		return err
+	})
+}
+
+func main() {
+	flag.Parse()
+
+	var r io.Reader = os.Stdin
+	if flag.NArg() > 0 {
+		f, err := os.Open(flag.Arg(0))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	if err := printTranscript(r, os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}